@@ -8,6 +8,7 @@ import (
 
 func benchmarkRecordDecode(input string, items uap.StandardUAP, b *testing.B) {
 	data, _ := util.HexStringToByte(input)
+	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
 		rec := new(Record)
 		unRead, err := rec.Decode(data, items)
@@ -72,6 +73,7 @@ func BenchmarkRecordDecode_Len73(b *testing.B) {
 
 func benchmarkDataBlockDecode(input string, b *testing.B) {
 	data, _ := util.HexStringToByte(input)
+	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
 		dataB := NewDataBlock()
 		unRead, err := dataB.Decode(data)
@@ -85,6 +87,63 @@ func benchmarkDataBlockDecode(input string, b *testing.B) {
 	}
 }
 
+func benchmarkRecordDecodeNoCopy(input string, items uap.StandardUAP, b *testing.B) {
+	data, _ := util.HexStringToByte(input)
+	b.ReportAllocs()
+	rec := NewRecord()
+	for n := 0; n < b.N; n++ {
+		rec.Reset()
+		unRead, err := rec.DecodeNoCopy(data, items)
+		if err != nil {
+			b.Errorf("FAIL: error = %v; Expected: %v", err, nil)
+		}
+		if unRead != 0 {
+			b.Errorf("FAIL: unRead = %v; Expected: %v", unRead, 0)
+		}
+	}
+}
+
+// benchmark some records without copy and with reuse of the record
+func BenchmarkRecordDecodeNoCopy_Len7(b *testing.B) {
+	benchmarkRecordDecodeNoCopy(
+		"e008837dfd9c58",
+		uap.Cat255StrV51,
+		b)
+}
+func BenchmarkRecordDecodeNoCopy_Len55(b *testing.B) {
+	benchmarkRecordDecodeNoCopy(
+		"fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+		uap.Cat048V127,
+		b)
+}
+func BenchmarkRecordDecodeNoCopy_Len68(b *testing.B) {
+	benchmarkRecordDecodeNoCopy(
+		"fc ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff",
+		uap.Cat4Test,
+		b)
+}
+func BenchmarkRecordDecodeNoCopy_Len73(b *testing.B) {
+	benchmarkRecordDecodeNoCopy(
+		"afbbf317f1300883040070a8bcf3ff07070723f0a8800713feb7022b0389038b140704012c080811580000001e7004f04aa004b0012400544e49413531313206c84c45424c48454c58",
+		uap.Cat030ArtasV70,
+		b)
+}
+
+func BenchmarkRecordDecodeItems_Len55(b *testing.B) {
+	data, _ := util.HexStringToByte("fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5")
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		rec := new(Record)
+		unRead, err := rec.DecodeItems(data, uap.Cat048V127, "I048/010", "I048/140", "I048/040")
+		if err != nil {
+			b.Errorf("FAIL: error = %v; Expected: %v", err, nil)
+		}
+		if unRead != 0 {
+			b.Errorf("FAIL: unRead = %v; Expected: %v", unRead, 0)
+		}
+	}
+}
+
 // benchmark one cat048 datablock
 func BenchmarkDataBlock_Len280(b *testing.B) {
 	benchmarkDataBlockDecode(
@@ -92,8 +151,33 @@ func BenchmarkDataBlock_Len280(b *testing.B) {
 		b)
 }
 
+func benchmarkDataBlockDecodeNoCopy(input string, b *testing.B) {
+	data, _ := util.HexStringToByte(input)
+	b.ReportAllocs()
+	dataB := NewDataBlock()
+	for n := 0; n < b.N; n++ {
+		dataB.Reset()
+		unRead, err := dataB.DecodeNoCopy(data)
+
+		if err != nil {
+			b.Errorf("FAIL: error = %v; Expected: %v", err, nil)
+		}
+		if unRead != 0 {
+			b.Errorf("FAIL: unRead = %v; Expected: %v", unRead, 0)
+		}
+	}
+}
+
+// benchmark one cat048 datablock without copy and with reuse of the datablock
+func BenchmarkDataBlockNoCopy_Len280(b *testing.B) {
+	benchmarkDataBlockDecodeNoCopy(
+		"300118fff7020836429b52a094c70181091302d06002b7490d0138a178cf422002e79a5d27a00c0060a3280030a4000040063a0743ce5b4020f5fff7020836429b54e000bc020901a2005c7802e800263946e50464b1cb6ca0029ea9491062a4546093880032d4000040059602f639590220f5fff7020836429b58a0909703ff026405a26002bb4066740815f6e795e002e56a0530ffdff860b0d80032fc00004003cf0810c9ef4020fdfff7020836429b56a0775d03700ec205786002be4060910815f9c363a002a49a0f30bfffff60c4600030a4000040057207674a004020fdfff7020836429b55a0468c029804b105786002c57101124d6070d3282002adfa3333a0140060c4600030a4000040026e07d75fc04020f5",
+		b)
+}
+
 func benchmarkWrapperDataBlockDecode(input string, b *testing.B) {
 	data, _ := util.HexStringToByte(input)
+	b.ReportAllocs()
 	for n := 0; n < b.N; n++ {
 		dataB, _ := NewWrapperDataBlock()
 		unRead, err := dataB.Decode(data)
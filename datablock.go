@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"github.com/mokhtarimokhtar/goasterix/uap"
 )
 
@@ -14,6 +15,9 @@ var (
 
 	// ErrCategoryUnknown reports which Category Unknown or not processed.
 	ErrCategoryUnknown = errors.New("[ASTERIX] category unknown or not processed")
+
+	// ErrLenUndersized reports that the LEN field is smaller than the size of CAT and LEN fields.
+	ErrLenUndersized = errors.New("[ASTERIX] length field undersized")
 )
 
 // WrapperDataBlock
@@ -22,6 +26,27 @@ var (
 // WrapperDataBlock = [CAT + LEN + RECORD + ...] + [ DATABLOCK ] + [...]
 type WrapperDataBlock struct {
 	DataBlocks []*DataBlock
+	Faults     []Fault
+}
+
+// Fault describes a data block which could not be decoded by DecodeLenient.
+// Offset is the position of the data block in the decoded buffer.
+// Record is the index of the faulty record in the data block, or -1 when the header (CAT + LEN) is faulty.
+// FRN is the FRN of the faulty item, or 0 when it is unknown.
+type Fault struct {
+	Offset   int
+	Category uint8
+	Record   int
+	FRN      uint8
+	Err      error
+}
+
+func (f Fault) Error() string {
+	return fmt.Sprintf("offset %d, category %d, record %d, FRN %d: %v", f.Offset, f.Category, f.Record, f.FRN, f.Err)
+}
+
+func (f Fault) Unwrap() error {
+	return f.Err
 }
 
 func NewWrapperDataBlock() (*WrapperDataBlock, error) {
@@ -29,15 +54,21 @@ func NewWrapperDataBlock() (*WrapperDataBlock, error) {
 	return w, nil
 }
 
+// Decode extracts one or more asterix data blocks using the uap.DefaultProfiles.
+// It returns the number of bytes unRead and fills the DataBlocks array.
 func (w *WrapperDataBlock) Decode(data []byte) (unRead int, err error) {
-	offset := uint16(0)
+	return w.decode(data, defaultProfile)
+}
+
+func (w *WrapperDataBlock) decode(data []byte, selector profileSelector) (unRead int, err error) {
+	offset := 0
 	for {
 		db := NewDataBlock()
-		unRead, err := db.Decode(data[offset:])
-		offset += db.Len
+		unRead, err := db.decode(data[offset:], selector)
 		if err != nil {
-			return unRead, err
+			return unRead, shiftOffset(err, offset)
 		}
+		offset += int(db.Len)
 
 		w.DataBlocks = append(w.DataBlocks, db)
 		if unRead == 0 {
@@ -47,6 +78,65 @@ func (w *WrapperDataBlock) Decode(data []byte) (unRead int, err error) {
 	return unRead, err
 }
 
+// DecodeLenient extracts one or more asterix data blocks using the uap.DefaultProfiles like Decode,
+// but a data block which cannot be decoded does not stop the decoding: it is skipped using its LEN field,
+// and reported in Faults. The data blocks successfully decoded are appended to DataBlocks.
+// The decoding stops only when the LEN field of a data block cannot be trusted (truncated or inconsistent),
+// it returns then the number of bytes unRead.
+func (w *WrapperDataBlock) DecodeLenient(data []byte) (unRead int) {
+	return w.decodeLenient(data, defaultProfile)
+}
+
+func (w *WrapperDataBlock) decodeLenient(data []byte, selector profileSelector) (unRead int) {
+	offset := 0
+	for offset < len(data) {
+		remaining := data[offset:]
+		if len(remaining) < 3 {
+			w.Faults = append(w.Faults, Fault{Offset: offset, Category: remaining[0], Record: -1, Err: ErrUndersized})
+			return len(remaining)
+		}
+		length := int(remaining[1])<<8 + int(remaining[2])
+		if length < 3 || length > len(remaining) {
+			err := ErrLenUndersized
+			if length > len(remaining) {
+				err = ErrUndersized
+			}
+			w.Faults = append(w.Faults, Fault{Offset: offset, Category: remaining[0], Record: -1, Err: err})
+			return len(remaining)
+		}
+
+		db := NewDataBlock()
+		_, err := db.decode(remaining[:length], selector)
+		if err != nil {
+			w.Faults = append(w.Faults, newFault(offset, db, shiftOffset(err, offset)))
+		} else {
+			w.DataBlocks = append(w.DataBlocks, db)
+		}
+		offset += length
+	}
+	return 0
+}
+
+// newFault returns the Fault of a data block at offset which decoding failed with err.
+func newFault(offset int, db *DataBlock, err error) Fault {
+	f := Fault{Offset: offset, Category: db.Category, Record: -1, Err: err}
+	var de *DecodeError
+	if errors.As(err, &de) {
+		f.Record = de.Record
+		f.FRN = de.FRN
+	}
+	return f
+}
+
+// shiftOffset adds offset to the Offset of err when it is a *DecodeError.
+func shiftOffset(err error, offset int) error {
+	var de *DecodeError
+	if errors.As(err, &de) {
+		de.Offset += offset
+	}
+	return err
+}
+
 // DataBlock
 // a DataBlock corresponds to one (only) category and contains one or more Records.
 // DataBlock = CAT + LEN + [FSPEC + items...] + [...] + ...
@@ -63,7 +153,14 @@ func NewDataBlock() *DataBlock {
 // Decode extracts an asterix data block: CAT + LEN + N * RECORD(S).
 // An asterix data block can contain a or more records.
 // It returns the number of bytes unRead and fills the DataBlock Struct(Category, Len, Records array) in byte.
+// A decoding error is returned as a *DecodeError, except io.EOF when data is empty.
+// The records are decoded with the uap.DefaultProfiles, the editions pinned in uap.DefaultRegistry
+// are not used: decode with a Decoder (see NewDecoder) to select them.
 func (db *DataBlock) Decode(data []byte) (int, error) {
+	return db.decode(data, defaultProfile)
+}
+
+func (db *DataBlock) decode(data []byte, selector profileSelector) (int, error) {
 	var unRead int
 	var err error
 	rb := bytes.NewReader(data)
@@ -79,15 +176,25 @@ func (db *DataBlock) Decode(data []byte) (int, error) {
 	err = binary.Read(rb, binary.BigEndian, &db.Len)
 	if err != nil {
 		unRead = rb.Len()
-		return unRead, err
+		return unRead, &DecodeError{Category: db.Category, Record: -1, Expected: 3, Available: len(data), Err: err}
+	}
+	if db.Len < 3 {
+		err = ErrLenUndersized
+		unRead = rb.Len()
+		return unRead, &DecodeError{Category: db.Category, Record: -1, Offset: 1, Err: err}
 	}
 	// check if the rest is big enough
-	rbSize := uint16(rb.Size())
-	if rbSize < db.Len {
+	if rb.Size() < int64(db.Len) {
 		db.Records = nil
 		err = ErrUndersized
 		unRead = rb.Len()
-		return unRead, err
+		return unRead, &DecodeError{
+			Category:  db.Category,
+			Record:    -1,
+			Expected:  int(db.Len),
+			Available: len(data),
+			Err:       err,
+		}
 	}
 
 	// retrieve records
@@ -99,23 +206,28 @@ func (db *DataBlock) Decode(data []byte) (int, error) {
 	offset := 0
 	lenData := len(tmp)
 
-	// selection of the appropriate UAP
-	uapSelected, found := uap.DefaultProfiles[db.Category]
-	if !found {
-		err = ErrCategoryUnknown
-		return unRead, err
-	}
-
 LoopRecords:
 	for {
+		// selection of the appropriate UAP
+		uapSelected, found := selector(db.Category, tmp[offset:])
+		if !found {
+			err = ErrCategoryUnknown
+			return unRead, &DecodeError{Category: db.Category, Record: len(db.Records), Offset: 3 + offset, Err: err}
+		}
+
 		rec := NewRecord()
 		unRead, err := rec.Decode(tmp[offset:], uapSelected)
 		db.Records = append(db.Records, rec)
-		offset = lenData - unRead
 
 		if err != nil {
+			var de *DecodeError
+			if errors.As(err, &de) {
+				de.Record = len(db.Records) - 1
+				de.Offset += 3 + offset
+			}
 			return unRead, err
 		}
+		offset = lenData - unRead
 		// offset == lenData is for the case payload is oversize of LEN field asterix
 		// if unRead == 0 || offset == lenData {
 		if unRead == 0 {
@@ -125,6 +237,16 @@ LoopRecords:
 	return unRead, nil
 }
 
+// profileSelector returns the UAP to apply for a record of the given category.
+// record contains the remaining bytes of the data block, starting at the FSPEC of the record.
+type profileSelector func(category uint8, record []byte) (uap.StandardUAP, bool)
+
+// defaultProfile selects the UAP of the category in uap.DefaultProfiles.
+func defaultProfile(category uint8, _ []byte) (uap.StandardUAP, bool) {
+	stdUAP, found := uap.DefaultProfiles[category]
+	return stdUAP, found
+}
+
 func (db DataBlock) String() [][]string {
 	var records [][]string
 	for _, record := range db.Records {
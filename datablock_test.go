@@ -1,6 +1,7 @@
 package goasterix
 
 import (
+	"errors"
 	"github.com/mokhtarimokhtar/goasterix/util"
 	"io"
 	"testing"
@@ -99,6 +100,112 @@ type DataBlockTest struct {
 	unRead       int   // the number of bytes not read.
 }
 
+func TestWrapperDataBlockDecodeLenient(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName   string
+		input          string
+		nbOfDataBlocks int
+		faults         []Fault
+		unRead         int
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName:   "CAT255 + CAT034 truncated record + unknown category + CAT255",
+			input:          "ff000ae008837e019d58 220006f60836 000005ffff ff000ae008837e019d58",
+			nbOfDataBlocks: 2,
+			faults: []Fault{
+				{Offset: 10, Category: 0x22, Record: 0, FRN: 2, Err: io.EOF},
+				{Offset: 16, Category: 0x00, Record: 0, FRN: 0, Err: ErrCategoryUnknown},
+			},
+			unRead: 0,
+		},
+		{
+			TestCaseName:   "undersized length field",
+			input:          "ff000ae008837e019d58 ff0002",
+			nbOfDataBlocks: 1,
+			faults: []Fault{
+				{Offset: 10, Category: 0xff, Record: -1, FRN: 0, Err: ErrLenUndersized},
+			},
+			unRead: 3,
+		},
+		{
+			TestCaseName:   "truncated header",
+			input:          "ff000ae008837e019d58 ff00",
+			nbOfDataBlocks: 1,
+			faults: []Fault{
+				{Offset: 10, Category: 0xff, Record: -1, FRN: 0, Err: ErrUndersized},
+			},
+			unRead: 2,
+		},
+		{
+			TestCaseName:   "length field oversized",
+			input:          "ff000ae008837e019d58 ff0010e008",
+			nbOfDataBlocks: 1,
+			faults: []Fault{
+				{Offset: 10, Category: 0xff, Record: -1, FRN: 0, Err: ErrUndersized},
+			},
+			unRead: 5,
+		},
+		{
+			TestCaseName:   "empty",
+			input:          "",
+			nbOfDataBlocks: 0,
+			faults:         nil,
+			unRead:         0,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		w, _ := NewWrapperDataBlock()
+
+		// Act
+		unRead := w.DecodeLenient(data)
+
+		// Assert
+		if unRead != row.unRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, row.unRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, row.unRead)
+		}
+		if len(w.DataBlocks) != row.nbOfDataBlocks {
+			t.Errorf("FAIL: %s nbOfDataBlocks = %v; Expected: %v", row.TestCaseName, len(w.DataBlocks), row.nbOfDataBlocks)
+		} else {
+			t.Logf("SUCCESS: nbOfDataBlocks = %v; Expected: %v", len(w.DataBlocks), row.nbOfDataBlocks)
+		}
+		if len(w.Faults) != len(row.faults) {
+			t.Errorf("FAIL: %s faults = %v; Expected: %v", row.TestCaseName, w.Faults, row.faults)
+			continue
+		}
+		for i, f := range w.Faults {
+			expected := row.faults[i]
+			if f.Offset != expected.Offset || f.Category != expected.Category || f.Record != expected.Record ||
+				f.FRN != expected.FRN || !errors.Is(f.Err, expected.Err) {
+				t.Errorf("FAIL: %s fault = %v; Expected: %v", row.TestCaseName, f, expected)
+			} else {
+				t.Logf("SUCCESS: fault = %v; Expected: %v", f, expected)
+			}
+		}
+	}
+}
+
+func TestFault_Unwrap(t *testing.T) {
+	// Arrange
+	var err error = Fault{Offset: 10, Category: 0x22, Record: 0, FRN: 2, Err: io.EOF}
+
+	// Act
+	res := errors.Is(err, io.EOF)
+
+	// Assert
+	if res == false || err.Error() != "offset 10, category 34, record 0, FRN 2: EOF" {
+		t.Errorf("FAIL: %v, %s; Expected: %v", res, err, true)
+	} else {
+		t.Logf("SUCCESS: %v, %s; Expected: %v", res, err, true)
+	}
+}
+
 func TestDataBlockDecode(t *testing.T) {
 	// setup
 	dataSet := []DataBlockTest{
@@ -223,6 +330,13 @@ func TestDataBlockDecode(t *testing.T) {
 			nbOfRecords:  47,
 			unRead:       0,
 		},
+		{
+			TestCaseName: "ErrLenUndersized",
+			input:        "30 0002 ff",
+			err:          ErrLenUndersized,
+			nbOfRecords:  0,
+			unRead:       1,
+		},
 		{
 			TestCaseName: "CAT034: over sized data block",
 			input:        "220014f6081002412998d89400002000940000811a",
@@ -241,7 +355,7 @@ func TestDataBlockDecode(t *testing.T) {
 		unRead, err := dataB.Decode(data)
 
 		// Assert
-		if err != row.err {
+		if !errors.Is(err, row.err) {
 			t.Errorf("FAIL: %s error: %s; Expected: %v", row.TestCaseName, err, row.err)
 		} else {
 			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
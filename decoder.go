@@ -0,0 +1,213 @@
+package goasterix
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// Decoder decodes asterix data blocks with its own registry of User Application Profiles.
+// It is seeded from the profiles of its registry and can be customised per category or per source (SAC/SIC)
+// without modifying the global uap.DefaultProfiles and uap.DefaultRegistry, so several decoders with different
+// profiles can be used concurrently, e.g. one per input feed.
+// The editions of its registry (uap.DefaultRegistry by default) can be selected per category or per source,
+// so feeds of several editions of a category are decoded together.
+// A Decoder is safe for concurrent use by multiple goroutines.
+type Decoder struct {
+	mu       sync.RWMutex
+	profiles map[uint8]uap.StandardUAP
+	sources  map[source]uap.StandardUAP
+	registry *uap.Registry
+
+	spDecoders map[spKey]SPDecoder
+}
+
+// source identifies a data source (SAC/SIC) for a given category.
+type source struct {
+	category uint8
+	sac      uint8
+	sic      uint8
+}
+
+// NewDecoder returns a Decoder seeded with the profile of each category of uap.DefaultRegistry, i.e. the profiles
+// of uap.DefaultProfiles unless another edition is pinned, see NewDecoderFromRegistry.
+func NewDecoder() *Decoder {
+	return NewDecoderFromRegistry(uap.DefaultRegistry)
+}
+
+// NewDecoderFromRegistry returns a Decoder seeded with the profile of each category of the registry
+// (its pinned or latest edition, see uap.Registry.Profile), the other editions are selected by SetEdition
+// and SetSourceEdition. The pins are taken at construction: pinning another edition in the registry
+// afterwards does not change the Decoder, use SetEdition instead.
+func NewDecoderFromRegistry(registry *uap.Registry) *Decoder {
+	return &Decoder{
+		profiles: registry.Profiles(),
+		sources:  make(map[source]uap.StandardUAP),
+		registry: registry,
+
+		spDecoders: make(map[spKey]SPDecoder),
+	}
+}
+
+// SetProfile registers stdUAP as the profile of its category (stdUAP.Category) and replaces the previous one.
+// e.g. d.SetProfile(uap.Cat030ArtasV62) decodes CAT030 with ARTAS profile instead of STR.
+func (d *Decoder) SetProfile(stdUAP uap.StandardUAP) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.profiles[stdUAP.Category] = stdUAP
+}
+
+// LoadProfile registers the profile read from the file (JSON, YAML or XML, see uap.LoadFile) as the profile
+// of its category and replaces the previous one. The profile is validated before it is registered,
+// so a file with errors leaves the Decoder unchanged.
+func (d *Decoder) LoadProfile(path string) error {
+	stdUAP, err := uap.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	d.SetProfile(stdUAP)
+	return nil
+}
+
+// SetSourceProfile registers stdUAP for the records of its category sent by the source identified by sac and sic.
+// The source is given by the Data Source Identifier (FRN 1) of each record, when the record does not contain it,
+// the profile of the category is used.
+func (d *Decoder) SetSourceProfile(sac uint8, sic uint8, stdUAP uap.StandardUAP) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sources[source{category: stdUAP.Category, sac: sac, sic: sic}] = stdUAP
+}
+
+// SetEdition registers the edition of the category of the registry as the profile of the category,
+// e.g. d.SetEdition(48, uap.Edition{Major: 1, Minor: 27}).
+// It returns an error wrapping uap.ErrEditionUnknown when the registry does not contain the edition.
+func (d *Decoder) SetEdition(category uint8, edition uap.Edition) error {
+	stdUAP, err := d.edition(category, edition)
+	if err != nil {
+		return err
+	}
+	d.SetProfile(stdUAP)
+	return nil
+}
+
+// SetSourceEdition registers the edition of the category of the registry for the records of the source identified
+// by sac and sic like SetSourceProfile, e.g. for a sensor still sending an older edition.
+// It returns an error wrapping uap.ErrEditionUnknown when the registry does not contain the edition.
+func (d *Decoder) SetSourceEdition(sac uint8, sic uint8, category uint8, edition uap.Edition) error {
+	stdUAP, err := d.edition(category, edition)
+	if err != nil {
+		return err
+	}
+	d.SetSourceProfile(sac, sic, stdUAP)
+	return nil
+}
+
+// edition returns the profile of the edition of the category in the registry.
+func (d *Decoder) edition(category uint8, edition uap.Edition) (uap.StandardUAP, error) {
+	stdUAP, found := d.registry.Lookup(category, edition)
+	if !found {
+		return uap.StandardUAP{}, fmt.Errorf("%w: category %d edition %s", uap.ErrEditionUnknown, category, edition)
+	}
+	return stdUAP, nil
+}
+
+// RemoveProfile removes the profile of the category and all its source profiles.
+// The data blocks of this category will be reported as ErrCategoryUnknown.
+func (d *Decoder) RemoveProfile(category uint8) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.profiles, category)
+	for src := range d.sources {
+		if src.category == category {
+			delete(d.sources, src)
+		}
+	}
+}
+
+// Profile returns the profile registered for the category.
+func (d *Decoder) Profile(category uint8) (uap.StandardUAP, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	stdUAP, found := d.profiles[category]
+	return stdUAP, found
+}
+
+// RecordProfile returns the profile selected by the Decoder for a record: the profile of its source (SAC/SIC)
+// if any, otherwise the profile of its category, i.e. the edition it was decoded with.
+func (d *Decoder) RecordProfile(rec Record) (uap.StandardUAP, bool) {
+	return d.selectProfile(rec.Cat, rec.Payload())
+}
+
+// Decode extracts an asterix data block: CAT + LEN + N * RECORD(S) with the profiles of the Decoder.
+// It returns the DataBlock, the number of bytes unRead and an error like DataBlock.Decode.
+func (d *Decoder) Decode(data []byte) (*DataBlock, int, error) {
+	db := NewDataBlock()
+	unRead, err := db.decode(data, d.selectProfile)
+	d.decodeSpecialPurpose(db)
+	return db, unRead, err
+}
+
+// DecodeWrapper extracts one or more asterix data blocks with the profiles of the Decoder.
+// It returns the WrapperDataBlock, the number of bytes unRead and an error like WrapperDataBlock.Decode.
+func (d *Decoder) DecodeWrapper(data []byte) (*WrapperDataBlock, int, error) {
+	w, _ := NewWrapperDataBlock()
+	unRead, err := w.decode(data, d.selectProfile)
+	d.decodeSpecialPurpose(w.DataBlocks...)
+	return w, unRead, err
+}
+
+// DecodeWrapperLenient extracts one or more asterix data blocks with the profiles of the Decoder,
+// skipping the data blocks which cannot be decoded like WrapperDataBlock.DecodeLenient.
+// It returns the WrapperDataBlock with its Faults and the number of bytes unRead.
+func (d *Decoder) DecodeWrapperLenient(data []byte) (*WrapperDataBlock, int) {
+	w, _ := NewWrapperDataBlock()
+	unRead := w.decodeLenient(data, d.selectProfile)
+	d.decodeSpecialPurpose(w.DataBlocks...)
+	return w, unRead
+}
+
+// DecodeWrapperParallel extracts one or more asterix data blocks with the profiles of the Decoder,
+// decoding the data blocks concurrently like WrapperDataBlock.DecodeParallel.
+// It returns the WrapperDataBlock, the number of bytes unRead and an error like WrapperDataBlock.DecodeParallel.
+func (d *Decoder) DecodeWrapperParallel(ctx context.Context, data []byte, workers int) (*WrapperDataBlock, int, error) {
+	w, _ := NewWrapperDataBlock()
+	unRead, err := w.decodeParallel(ctx, data, workers, d.selectProfile)
+	d.decodeSpecialPurpose(w.DataBlocks...)
+	return w, unRead, err
+}
+
+// selectProfile returns the profile of the source of the record if any, otherwise the profile of the category.
+func (d *Decoder) selectProfile(category uint8, record []byte) (uap.StandardUAP, bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if len(d.sources) != 0 {
+		if sac, sic, ok := sourceIdentifier(record); ok {
+			stdUAP, found := d.sources[source{category: category, sac: sac, sic: sic}]
+			if found {
+				return stdUAP, found
+			}
+		}
+	}
+	stdUAP, found := d.profiles[category]
+	return stdUAP, found
+}
+
+// sourceIdentifier returns the SAC/SIC of a record without decoding it.
+// In all categories, the FRN 1 is the Data Source Identifier (I0XX/010) of two octets
+// following immediately the FSPEC.
+func sourceIdentifier(record []byte) (sac uint8, sic uint8, ok bool) {
+	n := 0
+	for n < len(record) {
+		n++
+		if record[n-1]&0x01 == 0 {
+			break
+		}
+	}
+	if n == 0 || record[0]&0x80 == 0 || len(record) < n+2 {
+		return 0, 0, false
+	}
+	return record[n], record[n+1], true
+}
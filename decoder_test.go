@@ -0,0 +1,394 @@
+package goasterix
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+const (
+	cat030ArtasTest = "1e00f3afbbf317f1300883040070a8bcf3ff07070723f0a8800713feb7022b0389038b140704012c080811580000001e7004f04aa004b0012400544e49413531313206c84c45424c48454c584d413332300101a5389075c71ca0afbbf317f130088304002aa8bcf3ff04040447fda703f7d2008f0df705280528140700000008171158000000087002f0c3c00528012d006955414c3931202007314c4c42474b4557524842373757a290f3541339c60820afbbf31101300883040335a8bcf3ff0b0b0b2be9a9b5fffefffa0fff08c008c01d0e070000001484115800000200700400ffffffffffffffff344045df7df76021d3"
+	cat030StrTest   = "1e009fbffb0160088358052c7dfc04010e0fe86601c4720e008c008c01beff8bf027190439cc821885050e08203fff01605800847dfc04010e0a6968a7d6160e029d02a2fc660498f8feb917010c4caa2358f171dc15603ffb01605801d27dfc04010e0b1a6d60cf860e02d002d0fd460370f017010c4d02a6286076d518203ffb805805387dfc040f0e0e007593ccb20e00500050feb9ff5df017010c2205"
+)
+
+func TestDecoder_Decode(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		profile      uap.StandardUAP
+		nbOfRecords  int
+		err          error
+		unRead       int
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT030 ARTAS",
+			input:        cat030ArtasTest,
+			profile:      uap.Cat030ArtasV62,
+			nbOfRecords:  3,
+			err:          nil,
+			unRead:       0,
+		},
+		{
+			TestCaseName: "CAT030 STR",
+			input:        cat030StrTest,
+			profile:      uap.Cat030StrV51,
+			nbOfRecords:  4,
+			err:          nil,
+			unRead:       0,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		d := NewDecoder()
+		d.SetProfile(row.profile)
+
+		// Act
+		db, unRead, err := d.Decode(data)
+
+		// Assert
+		if err != row.err {
+			t.Errorf("FAIL: %s error: %s; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
+		}
+		if unRead != row.unRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, row.unRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, row.unRead)
+		}
+		if len(db.Records) != row.nbOfRecords {
+			t.Errorf("FAIL: %s nbOfRecords = %v; Expected: %v", row.TestCaseName, len(db.Records), row.nbOfRecords)
+		} else {
+			t.Logf("SUCCESS: nbOfRecords = %v; Expected: %v", len(db.Records), row.nbOfRecords)
+		}
+	}
+}
+
+func TestDecoder_Concurrent(t *testing.T) {
+	// Arrange
+	artas, _ := util.HexStringToByte(cat030ArtasTest)
+	str, _ := util.HexStringToByte(cat030StrTest)
+	dArtas := NewDecoder()
+	dArtas.SetProfile(uap.Cat030ArtasV62)
+	dStr := NewDecoder()
+	dStr.SetProfile(uap.Cat030StrV51)
+
+	// Act
+	var wg sync.WaitGroup
+	errs := make(chan error, 200)
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _, err := dArtas.Decode(artas)
+			errs <- err
+		}()
+		go func() {
+			defer wg.Done()
+			_, _, err := dStr.Decode(str)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	// Assert
+	for err := range errs {
+		if err != nil {
+			t.Errorf("FAIL: error: %s; Expected: %v", err, nil)
+		}
+	}
+}
+
+func TestDecoder_SetSourceProfile(t *testing.T) {
+	// Arrange
+	// CAT030 records come from SAC/SIC = 0x08/0x83, the ARTAS profile is selected for this source only
+	input := cat030ArtasTest + "ff000ae008837e019d58"
+	data, _ := util.HexStringToByte(input)
+	d := NewDecoder()
+	d.SetProfile(uap.Cat030StrV51)
+	d.SetSourceProfile(0x08, 0x83, uap.Cat030ArtasV62)
+
+	// Act
+	w, unRead, err := d.DecodeWrapper(data)
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error: %s; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+	}
+	if unRead != 0 {
+		t.Errorf("FAIL: unRead = %v; Expected: %v", unRead, 0)
+	} else {
+		t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, 0)
+	}
+	if len(w.DataBlocks) != 2 {
+		t.Fatalf("FAIL: nbOfDataBlocks = %v; Expected: %v", len(w.DataBlocks), 2)
+	}
+	if len(w.DataBlocks[0].Records) != 3 {
+		t.Errorf("FAIL: nbOfRecords = %v; Expected: %v", len(w.DataBlocks[0].Records), 3)
+	} else {
+		t.Logf("SUCCESS: nbOfRecords = %v; Expected: %v", len(w.DataBlocks[0].Records), 3)
+	}
+}
+
+func TestDecoder_RemoveProfile(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("ff000ae008837e019d58")
+	d := NewDecoder()
+	d.RemoveProfile(255)
+
+	// Act
+	_, _, err := d.Decode(data)
+
+	// Assert
+	if !errors.Is(err, ErrCategoryUnknown) {
+		t.Errorf("FAIL: error: %s; Expected: %v", err, ErrCategoryUnknown)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err, ErrCategoryUnknown)
+	}
+	if _, found := d.Profile(255); found {
+		t.Errorf("FAIL: found = %v; Expected: %v", found, false)
+	}
+	if _, found := uap.DefaultProfiles[255]; !found {
+		t.Errorf("FAIL: uap.DefaultProfiles modified")
+	}
+}
+
+func TestDecoder_LoadProfile(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "cat255.yaml")
+	invalid := filepath.Join(dir, "cat255_invalid.yaml")
+	_ = os.WriteFile(valid, []byte("name: cat255_site\ncategory: 255\nversion: 1.0\nitems:\n"+
+		"  - {frn: 1, dataItem: I255/010, type: fixed, fixed: {size: 2}}\n"), 0600)
+	_ = os.WriteFile(invalid, []byte("name: cat255_site\ncategory: 255\nitems:\n"+
+		"  - {frn: 1, dataItem: I255/010, type: fixed}\n"), 0600)
+	data, _ := util.HexStringToByte("ff0006 80 0883")
+	d := NewDecoder()
+
+	// Act
+	errInvalid := d.LoadProfile(invalid)
+	before, _ := d.Profile(255)
+	err := d.LoadProfile(valid)
+	db, _, errDecode := d.Decode(data)
+
+	// Assert
+	if !errors.Is(errInvalid, uap.ErrUAPInvalid) || before.Name != uap.Cat255StrV51.Name {
+		t.Errorf("FAIL: error: %v, profile: %s; Expected: %v, %s", errInvalid, before.Name, uap.ErrUAPInvalid, uap.Cat255StrV51.Name)
+	} else {
+		t.Logf("SUCCESS: error: %v, profile: %s; Expected: %v, %s", errInvalid, before.Name, uap.ErrUAPInvalid, uap.Cat255StrV51.Name)
+	}
+	if err != nil || errDecode != nil {
+		t.Fatalf("FAIL: error: %v, %v; Expected: %v", err, errDecode, nil)
+	}
+	if len(db.Records) != 1 || db.Records[0].Items[0].Meta.DataItem != "I255/010" {
+		t.Errorf("FAIL: records = %v; Expected: %s", db.Records, "I255/010")
+	} else {
+		t.Logf("SUCCESS: records = %v; Expected: %s", db.Records[0].String(), "I255/010")
+	}
+}
+
+func TestSourceIdentifier(t *testing.T) {
+	// setup
+	type dataTest struct {
+		input string
+		sac   uint8
+		sic   uint8
+		ok    bool
+	}
+	dataSet := []dataTest{
+		{input: "e0 0883 7e", sac: 0x08, sic: 0x83, ok: true},
+		{input: "ff 01 80 0102", sac: 0x01, sic: 0x02, ok: true},
+		{input: "60 0883", sac: 0, sic: 0, ok: false},
+		{input: "80 08", sac: 0, sic: 0, ok: false},
+		{input: "81", sac: 0, sic: 0, ok: false},
+		{input: "", sac: 0, sic: 0, ok: false},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+
+		// Act
+		sac, sic, ok := sourceIdentifier(data)
+
+		// Assert
+		if sac != row.sac || sic != row.sic || ok != row.ok {
+			t.Errorf("FAIL: %s = %v/%v %v; Expected: %v/%v %v", row.input, sac, sic, ok, row.sac, row.sic, row.ok)
+		} else {
+			t.Logf("SUCCESS: %s = %v/%v %v; Expected: %v/%v %v", row.input, sac, sic, ok, row.sac, row.sic, row.ok)
+		}
+	}
+}
+
+func TestDecoder_SetSourceEdition(t *testing.T) {
+	// Arrange
+	// CAT030 records come from SAC/SIC = 0x08/0x83, the edition 6.2 (ARTAS) is selected for this source only
+	data, _ := util.HexStringToByte(cat030ArtasTest)
+	d := NewDecoder()
+	errEdition := d.SetSourceEdition(0x08, 0x83, 30, uap.Edition{Major: 6, Minor: 2})
+
+	// Act
+	db, unRead, err := d.Decode(data)
+
+	// Assert
+	if errEdition != nil || err != nil {
+		t.Errorf("FAIL: error: %v, %v; Expected: %v", errEdition, err, nil)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+	}
+	if unRead != 0 || len(db.Records) != 3 {
+		t.Errorf("FAIL: unRead = %v, nbOfRecords = %v; Expected: %v, %v", unRead, len(db.Records), 0, 3)
+	} else {
+		t.Logf("SUCCESS: unRead = %v, nbOfRecords = %v; Expected: %v, %v", unRead, len(db.Records), 0, 3)
+	}
+}
+
+func TestDecoder_RecordProfile(t *testing.T) {
+	// Arrange
+	// CAT030 records come from SAC/SIC = 0x08/0x83, the edition 6.2 (ARTAS) is selected for this source only
+	data, _ := util.HexStringToByte(cat030ArtasTest)
+	d := NewDecoder()
+	_ = d.SetSourceEdition(0x08, 0x83, 30, uap.Edition{Major: 6, Minor: 2})
+	db, _, _ := d.Decode(data)
+	other := Record{Cat: 30, Fspec: []byte{0x80}, Items: []Item{{
+		Meta:  MetaItem{FRN: 1, DataItem: "I030/010", Type: uap.Fixed},
+		Fixed: &Fixed{Data: []byte{0x08, 0x84}},
+	}}}
+
+	// Act
+	stdUAP, found := d.RecordProfile(*db.Records[0])
+	otherUAP, otherFound := d.RecordProfile(other)
+
+	// Assert
+	if !found || stdUAP.Name != uap.Cat030ArtasV62.Name {
+		t.Errorf("FAIL: found = %v, profile = %s; Expected: %v, %s", found, stdUAP.Name, true, uap.Cat030ArtasV62.Name)
+	} else {
+		t.Logf("SUCCESS: found = %v, profile = %s; Expected: %v, %s", found, stdUAP.Name, true, uap.Cat030ArtasV62.Name)
+	}
+	defaultUAP, _ := uap.DefaultRegistry.Profile(30)
+	if !otherFound || otherUAP.Name != defaultUAP.Name {
+		t.Errorf("FAIL: found = %v, profile = %s; Expected: %v, %s", otherFound, otherUAP.Name, true, defaultUAP.Name)
+	} else {
+		t.Logf("SUCCESS: found = %v, profile = %s; Expected: %v, %s", otherFound, otherUAP.Name, true, defaultUAP.Name)
+	}
+}
+
+func TestDecoder_SetEdition(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		category     uint8
+		edition      uap.Edition
+		err          error
+		version      float64
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT030 ARTAS 7.0",
+			category:     30,
+			edition:      uap.Edition{Major: 7, Minor: 0},
+			err:          nil,
+			version:      7.0,
+		},
+		{
+			TestCaseName: "CAT048 1.15 not registered",
+			category:     48,
+			edition:      uap.Edition{Major: 1, Minor: 15},
+			err:          uap.ErrEditionUnknown,
+			version:      1.27,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		d := NewDecoder()
+
+		// Act
+		err := d.SetEdition(row.category, row.edition)
+
+		// Assert
+		if !errors.Is(err, row.err) {
+			t.Errorf("FAIL: %s error: %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
+		}
+		stdUAP, _ := d.Profile(row.category)
+		if stdUAP.Version != row.version {
+			t.Errorf("FAIL: %s version = %v; Expected: %v", row.TestCaseName, stdUAP.Version, row.version)
+		} else {
+			t.Logf("SUCCESS: version = %v; Expected: %v", stdUAP.Version, row.version)
+		}
+	}
+}
+
+func TestNewDecoderFromRegistry(t *testing.T) {
+	// Arrange
+	// the registry selects the latest edition of CAT030: ARTAS 7.0, unless the edition 6.2 is pinned
+	data, _ := util.HexStringToByte(cat030ArtasTest)
+	r := uap.NewRegistry(uap.Cat030StrV51, uap.Cat030ArtasV62, uap.Cat030ArtasV70)
+	errPin := r.Pin(30, uap.Edition{Major: 6, Minor: 2})
+	d := NewDecoderFromRegistry(r)
+
+	// Act
+	db, unRead, err := d.Decode(data)
+
+	// Assert
+	if errPin != nil || err != nil {
+		t.Errorf("FAIL: error: %v, %v; Expected: %v", errPin, err, nil)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+	}
+	if unRead != 0 || len(db.Records) != 3 {
+		t.Errorf("FAIL: unRead = %v, nbOfRecords = %v; Expected: %v, %v", unRead, len(db.Records), 0, 3)
+	} else {
+		t.Logf("SUCCESS: unRead = %v, nbOfRecords = %v; Expected: %v, %v", unRead, len(db.Records), 0, 3)
+	}
+	if _, found := d.Profile(48); found {
+		t.Errorf("FAIL: CAT048 found; Expected: only the categories of the registry")
+	}
+}
+
+func TestNewDecoder_DefaultRegistryPin(t *testing.T) {
+	// Arrange
+	// the edition 6.2 (ARTAS) of CAT030 is pinned in uap.DefaultRegistry before the creation of the Decoder,
+	// the pin of the edition 7.0 afterwards does not change it
+	defaultUAP, _ := uap.DefaultRegistry.Profile(30)
+	defer func() { _ = uap.DefaultRegistry.Pin(30, defaultUAP.Edition) }()
+	data, _ := util.HexStringToByte(cat030ArtasTest)
+	errPin := uap.DefaultRegistry.Pin(30, uap.Edition{Major: 6, Minor: 2})
+	d := NewDecoder()
+	errRePin := uap.DefaultRegistry.Pin(30, uap.Edition{Major: 7, Minor: 0})
+
+	// Act
+	db, unRead, err := d.Decode(data)
+	stdUAP, found := d.Profile(30)
+
+	// Assert
+	if errPin != nil || errRePin != nil || err != nil {
+		t.Errorf("FAIL: error: %v, %v, %v; Expected: %v", errPin, errRePin, err, nil)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+	}
+	if unRead != 0 || len(db.Records) != 3 {
+		t.Errorf("FAIL: unRead = %v, nbOfRecords = %v; Expected: %v, %v", unRead, len(db.Records), 0, 3)
+	} else {
+		t.Logf("SUCCESS: unRead = %v, nbOfRecords = %v; Expected: %v, %v", unRead, len(db.Records), 0, 3)
+	}
+	if !found || stdUAP.Name != uap.Cat030ArtasV62.Name {
+		t.Errorf("FAIL: found = %v, profile = %s; Expected: %v, %s", found, stdUAP.Name, true, uap.Cat030ArtasV62.Name)
+	} else {
+		t.Logf("SUCCESS: found = %v, profile = %s; Expected: %v, %s", found, stdUAP.Name, true, uap.Cat030ArtasV62.Name)
+	}
+}
@@ -6,6 +6,7 @@ datablock.go and record.go contain the logic built into goasterix for decoding p
 goasterix contains some sub-packages including:
  * uap: This contains all definition ASTERIX Profile (User Application Profile).
  * transform: This contains of the logic marshalling in JSON or XML format.
+ * specs: It converts the category definitions of the asterix-specs project (JSON) to UAP.
  * commbds: It is for decoding Comm-B Data Selector of transponder (ICAO Doc 9871:Technical Provisions for Mode S
 	Services and Extended Squitter)
 
@@ -0,0 +1,214 @@
+package goasterix
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// DumpOptions configures the human-readable dump of data blocks, records and items.
+// Bits prints the bits of each octet of the items, e.g. "octet 1: 1010 0000".
+type DumpOptions struct {
+	Bits bool
+}
+
+// dumper writes the lines of a dump with an indentation by depth, it keeps the first error of the writer.
+type dumper struct {
+	w    io.Writer
+	opts DumpOptions
+	err  error
+}
+
+func (d *dumper) line(depth int, format string, args ...interface{}) {
+	if d.err != nil {
+		return
+	}
+	_, d.err = fmt.Fprintf(d.w, strings.Repeat("  ", depth)+format+"\n", args...)
+}
+
+// Dump writes a human-readable tree of the data block to w: its header, then each record like Record.Dump
+// with stdUAP.
+func (db *DataBlock) Dump(w io.Writer, stdUAP uap.StandardUAP, opts DumpOptions) error {
+	d := &dumper{w: w, opts: opts}
+	d.line(0, "Category: %d, Len: %d", db.Category, db.Len)
+	for i, rec := range db.Records {
+		d.line(1, "Record %d", i+1)
+		d.record(2, rec, stdUAP)
+	}
+	return d.err
+}
+
+// Dump writes a human-readable tree of the record to w: the FSPEC with its FRNs, then each item with its
+// DataItem, the Description of its data field in stdUAP and its FRN. The parts of the items are detailed:
+// the extents of Extended items with their FX bit, the repetitions of Repetitive items, the sub-items of
+// Compound items named after their parent (e.g. "I062/380 ADR") and the fields of RFS items.
+// An item which is not defined by stdUAP is written with its Meta only.
+func (rec *Record) Dump(w io.Writer, stdUAP uap.StandardUAP, opts DumpOptions) error {
+	d := &dumper{w: w, opts: opts}
+	d.record(0, rec, stdUAP)
+	return d.err
+}
+
+// Dump writes a human-readable tree of the item to w like Record.Dump, field is its data field in the UAP.
+func (i *Item) Dump(w io.Writer, field uap.DataField, opts DumpOptions) error {
+	d := &dumper{w: w, opts: opts}
+	d.item(0, "", *i, field, nil)
+	return d.err
+}
+
+func (d *dumper) record(depth int, rec *Record, stdUAP uap.StandardUAP) {
+	var frns []string
+	for _, frn := range FspecIndex(rec.Fspec) {
+		frns = append(frns, fmt.Sprint(frn))
+	}
+	d.line(depth, "FSPEC: %s (FRN: %s)", hex.EncodeToString(rec.Fspec), strings.Join(frns, " "))
+	cond := stdUAP.EffectiveCondition()
+
+	fields := stdUAP.Items
+	for _, item := range rec.Items {
+		field, _ := lookupDataField(fields, MetaItem{FRN: item.Meta.FRN})
+		d.item(depth, "", item, field, fields)
+		if cond != nil && item.Meta.FRN == cond.FRN {
+			selected, err := selectUAPConditional(cond, item)
+			if err == nil {
+				fields = selected
+			}
+		}
+	}
+}
+
+// item writes the item and its parts, field is its data field (zero when unknown) and items the data fields
+// of the UAP used for the fields of a RFS item.
+// parent is the name of the compound item containing it, empty for an item of a record.
+func (d *dumper) item(depth int, parent string, item Item, field uap.DataField, items []uap.DataField) {
+	name := item.Meta.DataItem
+	if parent != "" {
+		name = parent + " " + name
+	}
+	description := field.Description
+	if description == "" {
+		description = item.Meta.Description
+	}
+	if description != "" {
+		name = name + " " + description
+	}
+	if parent == "" && item.Meta.FRN != 0 {
+		name = name + fmt.Sprintf(" (FRN %d)", item.Meta.FRN)
+	}
+	if parent == "" {
+		parent = item.Meta.DataItem
+	} else {
+		parent = parent + " " + item.Meta.DataItem
+	}
+
+	switch item.Meta.Type {
+	case uap.Fixed:
+		d.line(depth, "%s: %s", name, hex.EncodeToString(item.Fixed.Data))
+		d.bits(depth+1, item.Fixed.Data)
+
+	case uap.Extended:
+		d.line(depth, "%s: %s", name, item.Extended.String())
+		d.fxPart(depth+1, "primary", item.Extended.Primary)
+		size := int(field.Extended.SecondarySize)
+		if field.Type != uap.Extended || size == 0 || len(item.Extended.Secondary)%size != 0 {
+			d.fxPart(depth+1, "secondary", item.Extended.Secondary)
+			break
+		}
+		for j := 0; j < len(item.Extended.Secondary); j += size {
+			d.fxPart(depth+1, fmt.Sprintf("extent %d", j/size+1), item.Extended.Secondary[j:j+size])
+		}
+
+	case uap.Explicit:
+		d.line(depth, "%s: LEN %d, %s", name, item.Explicit.Len, hex.EncodeToString(item.Explicit.Data))
+		if item.Explicit.Compound != nil {
+			d.compound(depth+1, parent, *item.Explicit.Compound, field.Explicit.Compound)
+		} else {
+			d.bits(depth+1, item.Explicit.Data)
+		}
+
+	case uap.Repetitive:
+		d.line(depth, "%s: REP %d", name, item.Repetitive.Rep)
+		if item.Repetitive.Compounds != nil {
+			for j, cp := range item.Repetitive.Compounds {
+				d.line(depth+1, "[%d] %s", j+1, hex.EncodeToString(cp.Payload()))
+				d.compound(depth+2, parent, cp, field.Repetitive.Compound)
+			}
+		} else if item.Repetitive.Rep != 0 && len(item.Repetitive.Data)%int(item.Repetitive.Rep) == 0 {
+			size := len(item.Repetitive.Data) / int(item.Repetitive.Rep)
+			for j := 0; j < int(item.Repetitive.Rep); j++ {
+				data := item.Repetitive.Data[j*size : (j+1)*size]
+				d.line(depth+1, "[%d] %s", j+1, hex.EncodeToString(data))
+				d.bits(depth+2, data)
+			}
+		}
+
+	case uap.RepetitiveFX:
+		d.line(depth, "%s: %d repetitions", name, len(item.RepetitiveFX.Elements))
+		for j, e := range item.RepetitiveFX.Elements {
+			d.fxPart(depth+1, fmt.Sprintf("[%d]", j+1), e)
+		}
+
+	case uap.Compound:
+		d.line(depth, "%s", name)
+		d.compound(depth+1, parent, *item.Compound, field.Compound)
+
+	case uap.RFS:
+		d.line(depth, "%s: N %d", name, item.RFS.N)
+		for _, rf := range item.RFS.Sequence {
+			f, _ := lookupRandomField(items, rf.FRN)
+			d.line(depth+1, "FRN %d", rf.FRN)
+			d.item(depth+2, "", rf.Field, f, nil)
+		}
+
+	case uap.SP, uap.RE:
+		d.line(depth, "%s: LEN %d, %s", name, item.SP.Len, hex.EncodeToString(item.SP.Data))
+		if item.SP.Err != nil {
+			d.line(depth+1, "error: %v", item.SP.Err)
+		}
+		if item.SP.Items == nil {
+			d.bits(depth+1, item.SP.Data)
+		}
+		for _, sub := range item.SP.Items {
+			d.item(depth+1, parent, sub, uap.DataField{}, nil)
+		}
+
+	default:
+		d.line(depth, "%s: %s", name, hex.EncodeToString(item.Payload()))
+	}
+}
+
+// compound writes the primary subfield of cp and its sub-items named after parent,
+// fields are the data fields of the compound.
+func (d *dumper) compound(depth int, parent string, cp Compound, fields []uap.DataField) {
+	var frns []string
+	for _, frn := range FspecIndex(cp.Primary) {
+		frns = append(frns, fmt.Sprint(frn))
+	}
+	d.line(depth, "primary: %s (FRN: %s)", hex.EncodeToString(cp.Primary), strings.Join(frns, " "))
+	for _, sub := range cp.Secondary {
+		field, _ := lookupDataField(fields, MetaItem{FRN: sub.Meta.FRN})
+		d.item(depth, parent, sub, field, nil)
+	}
+}
+
+// fxPart writes a part of an item terminated by a FX bit (the last bit of its last octet).
+func (d *dumper) fxPart(depth int, label string, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	d.line(depth, "%s: %s (FX: %d)", label, hex.EncodeToString(data), data[len(data)-1]&0x01)
+	d.bits(depth+1, data)
+}
+
+// bits writes the bits of each octet of data when the option Bits is set.
+func (d *dumper) bits(depth int, data []byte) {
+	if !d.opts.Bits {
+		return
+	}
+	for j, b := range data {
+		d.line(depth, "octet %d: %04b %04b", j+1, b>>4, b&0x0f)
+	}
+}
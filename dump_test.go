@@ -0,0 +1,173 @@
+package goasterix
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestRecord_Dump(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name   string
+		input  string
+		uap    uap.StandardUAP
+		opts   DumpOptions
+		output string
+	}
+	dataSet := []testCase{
+		{
+			Name:  "testcase 1: fixed and extended items",
+			input: "e0 0836 429b52 a0",
+			uap:   uap.Cat048V127,
+			opts:  DumpOptions{},
+			output: "FSPEC: e0 (FRN: 1 2 3)\n" +
+				"I048/010 Data Source Identifier (FRN 1): 0836\n" +
+				"I048/140 Time-of-Day (FRN 2): 429b52\n" +
+				"I048/020 Target Report Descriptor (FRN 3): a0\n" +
+				"  primary: a0 (FX: 0)\n",
+		},
+		{
+			Name:  "testcase 2: bits of octets",
+			input: "e0 0836 429b52 a0",
+			uap:   uap.Cat048V127,
+			opts:  DumpOptions{Bits: true},
+			output: "FSPEC: e0 (FRN: 1 2 3)\n" +
+				"I048/010 Data Source Identifier (FRN 1): 0836\n" +
+				"  octet 1: 0000 1000\n" +
+				"  octet 2: 0011 0110\n" +
+				"I048/140 Time-of-Day (FRN 2): 429b52\n" +
+				"  octet 1: 0100 0010\n" +
+				"  octet 2: 1001 1011\n" +
+				"  octet 3: 0101 0010\n" +
+				"I048/020 Target Report Descriptor (FRN 3): a0\n" +
+				"  primary: a0 (FX: 0)\n" +
+				"    octet 1: 1010 0000\n",
+		},
+		{
+			Name:  "testcase 3: all types of items",
+			input: "fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff",
+			uap:   uap.Cat4Test,
+			opts:  DumpOptions{},
+			output: "FSPEC: fd40 (FRN: 1 2 3 4 5 6 9)\n" +
+				"I026/001 Fixed type field for test (FRN 1): ffff\n" +
+				"I026/002 Extended type field for test (FRN 2): fffffe\n" +
+				"  primary: ff (FX: 1)\n" +
+				"  extent 1: fffe (FX: 0)\n" +
+				"I026/003 Explicit type field for test (FRN 3): LEN 3, ffff\n" +
+				"I026/004 Repetitive type field for test (FRN 4): REP 2\n" +
+				"  [1] ffff\n" +
+				"  [2] ffff\n" +
+				"I026/005 Compound type field for test (FRN 5)\n" +
+				"  primary: ab80 (FRN: 1 3 5 7 8)\n" +
+				"  I026/005 Compound/001 Compound Fixed type field for test: ff\n" +
+				"  I026/005 Compound/003 Compound Extended type field for test: fffe\n" +
+				"    primary: ff (FX: 1)\n" +
+				"    extent 1: fe (FX: 0)\n" +
+				"  I026/005 Compound/005 Compound Repetitive type field for test: REP 2\n" +
+				"    [1] ffff\n" +
+				"    [2] ffff\n" +
+				"  I026/005 Compound/007 Compound Explicit type field for test: LEN 4, ffffff\n" +
+				"  I026/005 Compound/008 Compound Fixed type field for test: ffff\n" +
+				"I026/006 RFS(Random Field Sequencing) type field for test (FRN 6): N 1\n" +
+				"  FRN 1\n" +
+				"    I026/001 Fixed type field for test (FRN 1): ffff\n" +
+				"SP SP (Special Purpose field) type field for test (FRN 9): LEN 3, ffff\n",
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		input, _ := util.HexStringToByte(tc.input)
+		rec := new(Record)
+		_, _ = rec.Decode(input, tc.uap)
+		var buf bytes.Buffer
+
+		// Act
+		err := rec.Dump(&buf, tc.uap, tc.opts)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+		if buf.String() != tc.output {
+			t.Errorf("FAIL: %s - dump = \n%s; Expected: \n%s", tc.Name, buf.String(), tc.output)
+		} else {
+			t.Logf("SUCCESS: %s - dump = \n%s; Expected: \n%s", tc.Name, buf.String(), tc.output)
+		}
+	}
+}
+
+func TestDataBlock_Dump(t *testing.T) {
+	// Arrange
+	input, _ := util.HexStringToByte("300011 e0 0836 429b52 a0 e0 0836 429b52 a0")
+	db := new(DataBlock)
+	_, _ = db.Decode(input)
+	output := "Category: 48, Len: 17\n" +
+		"  Record 1\n" +
+		"    FSPEC: e0 (FRN: 1 2 3)\n" +
+		"    I048/010 Data Source Identifier (FRN 1): 0836\n" +
+		"    I048/140 Time-of-Day (FRN 2): 429b52\n" +
+		"    I048/020 Target Report Descriptor (FRN 3): a0\n" +
+		"      primary: a0 (FX: 0)\n" +
+		"  Record 2\n" +
+		"    FSPEC: e0 (FRN: 1 2 3)\n" +
+		"    I048/010 Data Source Identifier (FRN 1): 0836\n" +
+		"    I048/140 Time-of-Day (FRN 2): 429b52\n" +
+		"    I048/020 Target Report Descriptor (FRN 3): a0\n" +
+		"      primary: a0 (FX: 0)\n"
+	var buf bytes.Buffer
+
+	// Act
+	err := db.Dump(&buf, uap.Cat048V127, DumpOptions{})
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: err = %v; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: err = %v; Expected: %v", err, nil)
+	}
+	if buf.String() != output {
+		t.Errorf("FAIL: dump = \n%s; Expected: \n%s", buf.String(), output)
+	} else {
+		t.Logf("SUCCESS: dump = \n%s; Expected: \n%s", buf.String(), output)
+	}
+}
+
+func TestItem_Dump(t *testing.T) {
+	// Arrange
+	item := Item{
+		Meta:         MetaItem{FRN: 10, DataItem: "I062/510", Type: uap.RepetitiveFX},
+		RepetitiveFX: &RepetitiveFX{Elements: [][]byte{{0x01, 0x02, 0x03}, {0x04, 0x05, 0x06}}},
+	}
+	field := uap.DataField{FRN: 10, DataItem: "I062/510", Description: "Composed Track Number", Type: uap.RepetitiveFX}
+	output := "I062/510 Composed Track Number (FRN 10): 2 repetitions\n" +
+		"  [1]: 010203 (FX: 1)\n" +
+		"    octet 1: 0000 0001\n" +
+		"    octet 2: 0000 0010\n" +
+		"    octet 3: 0000 0011\n" +
+		"  [2]: 040506 (FX: 0)\n" +
+		"    octet 1: 0000 0100\n" +
+		"    octet 2: 0000 0101\n" +
+		"    octet 3: 0000 0110\n"
+	var buf bytes.Buffer
+
+	// Act
+	err := item.Dump(&buf, field, DumpOptions{Bits: true})
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: err = %v; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: err = %v; Expected: %v", err, nil)
+	}
+	if buf.String() != output {
+		t.Errorf("FAIL: dump = \n%s; Expected: \n%s", buf.String(), output)
+	} else {
+		t.Logf("SUCCESS: dump = \n%s; Expected: \n%s", buf.String(), output)
+	}
+}
@@ -0,0 +1,78 @@
+package goasterix
+
+import (
+	"errors"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// ErrItemAbsent reports that an item is not present in the record.
+var ErrItemAbsent = errors.New("[ASTERIX] item not present in record")
+
+// SetItem adds item to the record or replaces the item of the record with the same FRN
+// (or the same DataItem when Meta.FRN is zero).
+// The item is encoded like Record.Encode: the items stay ordered by FRN and the FSPEC is recomputed.
+// The record is left unchanged when an error is returned.
+func (rec *Record) SetItem(stdUAP uap.StandardUAP, item Item) error {
+	items := make([]Item, 0, len(rec.Items)+1)
+	for _, it := range rec.Items {
+		if !sameItem(it.Meta, item.Meta) {
+			items = append(items, it)
+		}
+	}
+	items = append(items, item)
+	return rec.Encode(stdUAP, items)
+}
+
+// ReplaceItem replaces the item of the record with the same FRN (or the same DataItem when Meta.FRN is zero)
+// like SetItem, it returns ErrItemAbsent when the record does not contain it.
+func (rec *Record) ReplaceItem(stdUAP uap.StandardUAP, item Item) error {
+	for _, it := range rec.Items {
+		if sameItem(it.Meta, item.Meta) {
+			return rec.SetItem(stdUAP, item)
+		}
+	}
+	return ErrItemAbsent
+}
+
+// RemoveItem removes the item identified by its FRN from the record and recomputes the FSPEC.
+// It returns ErrItemAbsent when the record does not contain it.
+// When the item selects a conditional UAP, the remaining items are not checked: Record.Validate reports them.
+func (rec *Record) RemoveItem(frn uint8) error {
+	for i, it := range rec.Items {
+		if it.Meta.FRN != frn {
+			continue
+		}
+		items := make([]Item, 0, len(rec.Items)-1)
+		items = append(items, rec.Items[:i]...)
+		items = append(items, rec.Items[i+1:]...)
+
+		frnIndex := make([]uint8, 0, len(items))
+		for _, item := range items {
+			frnIndex = append(frnIndex, item.Meta.FRN)
+		}
+		rec.Fspec = FspecFromIndex(frnIndex)
+		rec.Items = items
+		return nil
+	}
+	return ErrItemAbsent
+}
+
+// sameItem returns true if a identifies the same item as b: by FRN or when the FRN of b is zero by DataItem.
+func sameItem(a MetaItem, b MetaItem) bool {
+	if b.FRN != 0 {
+		return a.FRN == b.FRN
+	}
+	return b.DataItem != "" && a.DataItem == b.DataItem
+}
+
+// UpdateLen recomputes the LEN field of the data block from its records, e.g. after editing them.
+// It returns ErrOversized when the data block exceeds the maximum length of LEN field.
+func (db *DataBlock) UpdateLen() error {
+	length, err := dataBlockLen(db.Records)
+	if err != nil {
+		return err
+	}
+	db.Len = length
+	return nil
+}
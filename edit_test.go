@@ -0,0 +1,135 @@
+package goasterix
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestRecord_Edit(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		edit         func(rec *Record) error
+		err          error
+		output       string
+	}
+	input := "fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5"
+	dataSet := []dataTest{
+		{
+			TestCaseName: "remove I048/250",
+			edit: func(rec *Record) error {
+				return rec.RemoveItem(10)
+			},
+			err:    nil,
+			output: "ffd702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 063a 0743ce5b 40 20f5",
+		},
+		{
+			TestCaseName: "remove absent item",
+			edit: func(rec *Record) error {
+				return rec.RemoveItem(12)
+			},
+			err:    ErrItemAbsent,
+			output: input,
+		},
+		{
+			TestCaseName: "set SAC/SIC by FRN",
+			edit: func(rec *Record) error {
+				return rec.SetItem(uap.Cat048V127, Item{Meta: MetaItem{FRN: 1}, Fixed: &Fixed{Data: []byte{0x01, 0x02}}})
+			},
+			err:    nil,
+			output: "fff702 0102 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+		},
+		{
+			TestCaseName: "set new item by DataItem",
+			edit: func(rec *Record) error {
+				return rec.SetItem(uap.Cat048V127, Item{Meta: MetaItem{DataItem: "I048/042"}, Fixed: &Fixed{Data: []byte{0x01, 0x02, 0x03, 0x04}}})
+			},
+			err:    nil,
+			output: "ffff02 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 01020304 0743ce5b 40 20f5",
+		},
+		{
+			TestCaseName: "replace SAC/SIC by DataItem",
+			edit: func(rec *Record) error {
+				return rec.ReplaceItem(uap.Cat048V127, Item{Meta: MetaItem{DataItem: "I048/010"}, Fixed: &Fixed{Data: []byte{0x01, 0x02}}})
+			},
+			err:    nil,
+			output: "fff702 0102 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+		},
+		{
+			TestCaseName: "replace absent item",
+			edit: func(rec *Record) error {
+				return rec.ReplaceItem(uap.Cat048V127, Item{Meta: MetaItem{FRN: 12}, Fixed: &Fixed{Data: []byte{0x01, 0x02, 0x03, 0x04}}})
+			},
+			err:    ErrItemAbsent,
+			output: input,
+		},
+		{
+			TestCaseName: "set malformed item",
+			edit: func(rec *Record) error {
+				return rec.SetItem(uap.Cat048V127, Item{Meta: MetaItem{FRN: 1}, Fixed: &Fixed{Data: []byte{0x01}}})
+			},
+			err:    ErrItemMalformed,
+			output: input,
+		},
+		{
+			TestCaseName: "set unknown item",
+			edit: func(rec *Record) error {
+				return rec.SetItem(uap.Cat048V127, Item{Meta: MetaItem{DataItem: "I048/999"}, Fixed: &Fixed{Data: []byte{0x01}}})
+			},
+			err:    ErrItemUnknown,
+			output: input,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(input)
+		output, _ := util.HexStringToByte(row.output)
+		rec := NewRecord()
+		_, _ = rec.Decode(data, uap.Cat048V127)
+
+		// Act
+		err := row.edit(rec)
+
+		// Assert
+		if errors.Is(err, row.err) == false {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, row.err)
+		}
+		if bytes.Equal(rec.Payload(), output) == false {
+			t.Errorf("FAIL: %s payload = %x; Expected: %x", row.TestCaseName, rec.Payload(), output)
+		} else {
+			t.Logf("SUCCESS: payload = %x; Expected: %x", rec.Payload(), output)
+		}
+		if violations := rec.Validate(uap.Cat048V127); violations != nil {
+			t.Errorf("FAIL: %s violations = %v; Expected: %v", row.TestCaseName, violations, nil)
+		}
+	}
+}
+
+func TestDataBlock_UpdateLen(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("300011 e0 0836 429b52 a0 e0 0836 429b52 a0")
+	output, _ := util.HexStringToByte("30000e a0 0836 a0 e0 0836 429b52 a0")
+	db := NewDataBlock()
+	_, _ = db.Decode(data)
+	_ = db.Records[0].RemoveItem(2)
+
+	// Act
+	err := db.UpdateLen()
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error = %v; Expected: %v", err, nil)
+	}
+	if bytes.Equal(bytes.Join(db.Payload(), nil), output) == false {
+		t.Errorf("FAIL: payload = %x; Expected: %x", bytes.Join(db.Payload(), nil), output)
+	} else {
+		t.Logf("SUCCESS: payload = %x; Expected: %x", bytes.Join(db.Payload(), nil), output)
+	}
+}
@@ -0,0 +1,383 @@
+package goasterix
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+var (
+	// ErrItemUnknown reports that an item is not defined by the UAP.
+	ErrItemUnknown = errors.New("[ASTERIX] item unknown in UAP")
+
+	// ErrItemMalformed reports that the content of an item does not match its UAP definition.
+	ErrItemMalformed = errors.New("[ASTERIX] item malformed")
+
+	// ErrItemDuplicated reports that an item (FRN) is given more than once.
+	ErrItemDuplicated = errors.New("[ASTERIX] item duplicated")
+
+	// ErrCategoryMismatch reports that a record does not belong to the category of the data block.
+	ErrCategoryMismatch = errors.New("[ASTERIX] record category mismatch")
+
+	// ErrOversized reports that a data block exceeds the maximum length (65535 bytes) of LEN field.
+	ErrOversized = errors.New("[ASTERIX] oversized data block")
+)
+
+// Encode builds a Record from items according to the UAP stdUAP.
+// Each item is identified by its Meta.FRN or, when Meta.FRN is zero, by its Meta.DataItem, and contains the data
+// corresponding to its type: Fixed, Extended, Explicit, Repetitive, Compound, RFS, or SP for SP and RE fields.
+// The sub-items of a Compound are identified the same way in the compound definition of the UAP.
+// Encode completes the Meta of items from the UAP, sorts them by FRN and computes the FSPEC, the primary subfield
+// of compound items, the FX bits of extended items, the REP factor of repetitive items, the number of fields of
+// RFS items and the length indicators.
+// The items given are not modified.
+func (rec *Record) Encode(stdUAP uap.StandardUAP, items []Item) error {
+	encoded, err := encodeItems(stdUAP, items)
+	if err != nil {
+		return err
+	}
+
+	var frnIndex []uint8
+	for _, item := range encoded {
+		frnIndex = append(frnIndex, item.Meta.FRN)
+	}
+	rec.Cat = stdUAP.Category
+	rec.Fspec = FspecFromIndex(frnIndex)
+	rec.Items = encoded
+	return nil
+}
+
+// Encode builds a DataBlock of category from records and computes its LEN field.
+// The records can be built by Record.Encode or by Record.Decode.
+func (db *DataBlock) Encode(category uint8, records []*Record) error {
+	for _, rec := range records {
+		if rec.Cat != category {
+			return ErrCategoryMismatch
+		}
+	}
+	length, err := dataBlockLen(records)
+	if err != nil {
+		return err
+	}
+
+	db.Category = category
+	db.Len = length
+	db.Records = records
+	return nil
+}
+
+// encodeItems returns the items encoded and sorted by FRN.
+// The items of the UAP are resolved first, then if a conditional item is present,
+// the remaining items are resolved with the UAP selected by it.
+func encodeItems(stdUAP uap.StandardUAP, items []Item) ([]Item, error) {
+	encoded := make([]Item, 0, len(items))
+	resolved := make([]bool, len(items))
+	cond := stdUAP.EffectiveCondition()
+
+	fields := stdUAP.Items
+	for fields != nil {
+		var selected []uap.DataField
+		for i, item := range items {
+			if resolved[i] {
+				continue
+			}
+			field, found := lookupDataField(fields, item.Meta)
+			if !found {
+				continue
+			}
+			tmp, err := encodeItem(item, field, fields)
+			if err != nil {
+				return nil, err
+			}
+			encoded = append(encoded, *tmp)
+			resolved[i] = true
+
+			if cond != nil && field.FRN == cond.FRN {
+				selected, err = selectUAPConditional(cond, *tmp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		fields = selected
+	}
+
+	for _, ok := range resolved {
+		if !ok {
+			return nil, ErrItemUnknown
+		}
+	}
+	return sortItems(encoded)
+}
+
+// sortItems sorts items by FRN and checks that each FRN is unique.
+func sortItems(items []Item) ([]Item, error) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Meta.FRN < items[j].Meta.FRN
+	})
+	for i := 1; i < len(items); i++ {
+		if items[i].Meta.FRN == items[i-1].Meta.FRN {
+			return nil, ErrItemDuplicated
+		}
+	}
+	return items, nil
+}
+
+// lookupDataField returns the data field of fields identified by the FRN or when it's zero by the DataItem of meta.
+func lookupDataField(fields []uap.DataField, meta MetaItem) (uap.DataField, bool) {
+	for _, field := range fields {
+		if field.Type == uap.Spare {
+			continue
+		}
+		if meta.FRN != 0 && field.FRN == meta.FRN {
+			return field, true
+		}
+		if meta.FRN == 0 && meta.DataItem != "" && field.DataItem == meta.DataItem {
+			return field, true
+		}
+	}
+	return uap.DataField{}, false
+}
+
+// encodeItem returns a copy of item checked against the data field of the UAP
+// with its length indicators, REP factor and FX bits computed.
+// The random fields of a RFS item are checked against the data fields of items.
+func encodeItem(item Item, field uap.DataField, items []uap.DataField) (*Item, error) {
+	tmp := NewItem(field)
+	switch field.Type {
+	case uap.Fixed:
+		if item.Fixed == nil || len(item.Fixed.Data) != int(field.Fixed.Size) {
+			return nil, ErrItemMalformed
+		}
+		tmp.Fixed = &Fixed{Data: append([]byte(nil), item.Fixed.Data...)}
+
+	case uap.Extended:
+		e, err := encodeExtended(item.Extended, field.Extended)
+		if err != nil {
+			return nil, err
+		}
+		tmp.Extended = e
+
+	case uap.Explicit:
+		if item.Explicit == nil {
+			return nil, ErrItemMalformed
+		}
+		if field.Explicit.Compound != nil {
+			e, err := encodeExplicitCompound(item.Explicit, field.Explicit.Compound)
+			if err != nil {
+				return nil, err
+			}
+			tmp.Explicit = e
+			break
+		}
+		if len(item.Explicit.Data) > 0xFE {
+			return nil, ErrItemMalformed
+		}
+		tmp.Explicit = &Explicit{
+			Len:  uint8(len(item.Explicit.Data) + 1),
+			Data: append([]byte(nil), item.Explicit.Data...),
+		}
+
+	case uap.Repetitive:
+		if field.Repetitive.Compound != nil {
+			r, err := encodeRepetitiveCompound(item.Repetitive, field.Repetitive.Compound)
+			if err != nil {
+				return nil, err
+			}
+			tmp.Repetitive = r
+			break
+		}
+		size := int(field.Repetitive.SubItemSize)
+		if item.Repetitive == nil || size == 0 || len(item.Repetitive.Data)%size != 0 ||
+			len(item.Repetitive.Data)/size > 0xFF {
+			return nil, ErrItemMalformed
+		}
+		tmp.Repetitive = &Repetitive{
+			Rep:  uint8(len(item.Repetitive.Data) / size),
+			Data: append([]byte(nil), item.Repetitive.Data...),
+		}
+
+	case uap.RepetitiveFX:
+		r, err := encodeRepetitiveFX(item.RepetitiveFX, field.Repetitive)
+		if err != nil {
+			return nil, err
+		}
+		tmp.RepetitiveFX = r
+
+	case uap.Compound:
+		if item.Compound == nil {
+			return nil, ErrItemMalformed
+		}
+		cp, err := encodeCompound(item.Compound.Secondary, field.Compound)
+		if err != nil {
+			return nil, err
+		}
+		tmp.Compound = cp
+
+	case uap.RFS:
+		rfs, err := encodeRFS(item.RFS, items)
+		if err != nil {
+			return nil, err
+		}
+		tmp.RFS = rfs
+
+	case uap.SP, uap.RE:
+		if item.SP == nil || len(item.SP.Data) > 0xFE {
+			return nil, ErrItemMalformed
+		}
+		tmp.SP = &SpecialPurpose{
+			Len:  uint8(len(item.SP.Data) + 1),
+			Data: append([]byte(nil), item.SP.Data...),
+		}
+
+	default:
+		return nil, ErrDataFieldUnknown
+	}
+	return tmp, nil
+}
+
+// encodeRFS returns a copy of rfs with the number N of data fields computed.
+// Each random field is identified by its FRN or, when it's zero, by the Meta of its field.
+func encodeRFS(rfs *RandomFieldSequencing, items []uap.DataField) (*RandomFieldSequencing, error) {
+	if rfs == nil || len(rfs.Sequence) > 0xFF {
+		return nil, ErrItemMalformed
+	}
+	tmp := &RandomFieldSequencing{N: uint8(len(rfs.Sequence))}
+	for _, rf := range rfs.Sequence {
+		meta := rf.Field.Meta
+		if rf.FRN != 0 {
+			meta = MetaItem{FRN: rf.FRN}
+		}
+		field, found := lookupDataField(items, meta)
+		if !found || field.Type == uap.RFS {
+			return nil, ErrItemUnknown
+		}
+		f, err := encodeItem(rf.Field, field, nil)
+		if err != nil {
+			return nil, err
+		}
+		tmp.Sequence = append(tmp.Sequence, RandomField{FRN: field.FRN, Field: *f})
+	}
+	return tmp, nil
+}
+
+// encodeExtended returns a copy of e with the FX bit of each part set according to the presence of a next part.
+func encodeExtended(e *Extended, field uap.ExtendedField) (*Extended, error) {
+	primarySize := int(field.PrimarySize)
+	secondarySize := int(field.SecondarySize)
+	if e == nil || primarySize == 0 || len(e.Primary) != primarySize {
+		return nil, ErrItemMalformed
+	}
+	if len(e.Secondary) != 0 && (secondarySize == 0 || len(e.Secondary)%secondarySize != 0) {
+		return nil, ErrItemMalformed
+	}
+
+	tmp := &Extended{Primary: append([]byte(nil), e.Primary...)}
+	if len(e.Secondary) == 0 {
+		tmp.Primary[primarySize-1] &^= 0x01
+		return tmp, nil
+	}
+	tmp.Primary[primarySize-1] |= 0x01
+	tmp.Secondary = append([]byte(nil), e.Secondary...)
+	for i := secondarySize - 1; i < len(tmp.Secondary); i += secondarySize {
+		if i == len(tmp.Secondary)-1 {
+			tmp.Secondary[i] &^= 0x01
+		} else {
+			tmp.Secondary[i] |= 0x01
+		}
+	}
+	return tmp, nil
+}
+
+// encodeRepetitiveFX returns a copy of r with the FX bit of each repetition set according to the presence
+// of a next repetition.
+func encodeRepetitiveFX(r *RepetitiveFX, field uap.RepetitiveField) (*RepetitiveFX, error) {
+	size := int(field.SubItemSize)
+	if r == nil || size == 0 || len(r.Elements) == 0 {
+		return nil, ErrItemMalformed
+	}
+	tmp := &RepetitiveFX{}
+	for i, e := range r.Elements {
+		if len(e) != size {
+			return nil, ErrItemMalformed
+		}
+		e = append([]byte(nil), e...)
+		if i == len(r.Elements)-1 {
+			e[size-1] &^= 0x01
+		} else {
+			e[size-1] |= 0x01
+		}
+		tmp.Elements = append(tmp.Elements, e)
+	}
+	return tmp, nil
+}
+
+// encodeExplicitCompound returns a copy of e whose contents is the compound e.Compound encoded with fields,
+// with its length indicator computed.
+func encodeExplicitCompound(e *Explicit, fields []uap.DataField) (*Explicit, error) {
+	if e.Compound == nil {
+		return nil, ErrItemMalformed
+	}
+	cp, err := encodeCompound(e.Compound.Secondary, fields)
+	if err != nil {
+		return nil, err
+	}
+	data := cp.Payload()
+	if len(data) > 0xFE {
+		return nil, ErrItemMalformed
+	}
+	return &Explicit{Len: uint8(len(data) + 1), Data: data, Compound: cp}, nil
+}
+
+// encodeRepetitiveCompound returns a copy of r whose repetitions are the compounds r.Compounds encoded with fields,
+// with its REP factor computed.
+func encodeRepetitiveCompound(r *Repetitive, fields []uap.DataField) (*Repetitive, error) {
+	if r == nil || len(r.Compounds) > 0xFF {
+		return nil, ErrItemMalformed
+	}
+	tmp := &Repetitive{Rep: uint8(len(r.Compounds)), Data: []byte{}}
+	for _, c := range r.Compounds {
+		cp, err := encodeCompound(c.Secondary, fields)
+		if err != nil {
+			return nil, err
+		}
+		tmp.Compounds = append(tmp.Compounds, *cp)
+		tmp.Data = append(tmp.Data, cp.Payload()...)
+	}
+	return tmp, nil
+}
+
+// encodeCompound returns a Compound of sub-items sorted by FRN with its primary subfield computed.
+// Data subfields shall be either fixed length, extended length, explicit length, repetitive or compound.
+func encodeCompound(items []Item, fields []uap.DataField) (*Compound, error) {
+	cp := &Compound{}
+	var frnIndex []uint8
+	for _, item := range items {
+		field, found := lookupDataField(fields, item.Meta)
+		if !found {
+			return nil, ErrItemUnknown
+		}
+		switch field.Type {
+		case uap.Fixed, uap.Extended, uap.Explicit, uap.Repetitive, uap.RepetitiveFX, uap.Compound:
+		default:
+			return nil, ErrDataFieldUnknown
+		}
+		tmp, err := encodeItem(item, field, nil)
+		if err != nil {
+			return nil, err
+		}
+		cp.Secondary = append(cp.Secondary, *tmp)
+	}
+
+	var err error
+	cp.Secondary, err = sortItems(cp.Secondary)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range cp.Secondary {
+		frnIndex = append(frnIndex, item.Meta.FRN)
+	}
+	cp.Primary = FspecFromIndex(frnIndex)
+	return cp, nil
+}
@@ -0,0 +1,392 @@
+package goasterix
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+// stripItems returns a copy of the decoded items identified only by their FRN,
+// without FSPEC, primary subfields, FX bits, REP factors nor length indicators.
+func stripItems(items []Item) []Item {
+	var stripped []Item
+	for _, item := range items {
+		tmp := Item{Meta: MetaItem{FRN: item.Meta.FRN}}
+		switch item.Meta.Type {
+		case uap.Fixed:
+			tmp.Fixed = &Fixed{Data: item.Fixed.Data}
+		case uap.Extended:
+			tmp.Extended = &Extended{Primary: []byte{item.Extended.Primary[0] &^ 0x01}}
+			for i, b := range item.Extended.Secondary {
+				tmp.Extended.Secondary = append(tmp.Extended.Secondary, b)
+				if i == len(item.Extended.Secondary)-1 {
+					tmp.Extended.Secondary[i] ^= 0x01 // wrong FX bit
+				}
+			}
+		case uap.Explicit:
+			tmp.Explicit = &Explicit{Data: item.Explicit.Data}
+		case uap.Repetitive:
+			tmp.Repetitive = &Repetitive{Data: item.Repetitive.Data}
+		case uap.Compound:
+			tmp.Compound = &Compound{Secondary: stripItems(item.Compound.Secondary)}
+		case uap.RFS:
+			tmp.RFS = &RandomFieldSequencing{}
+			for _, rf := range item.RFS.Sequence {
+				fields := stripItems([]Item{rf.Field})
+				tmp.RFS.Sequence = append(tmp.RFS.Sequence, RandomField{FRN: rf.FRN, Field: fields[0]})
+			}
+		case uap.SP, uap.RE:
+			tmp.SP = &SpecialPurpose{Data: item.SP.Data}
+		}
+		// reverse order
+		stripped = append([]Item{tmp}, stripped...)
+	}
+	return stripped
+}
+
+func TestRecord_Encode(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		uap          uap.StandardUAP
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT048",
+			input:        "ffdf029319378d3da2056f132d0fff00946002de506f844cc3c35123310017013b026c000c74a74020a0",
+			uap:          uap.Cat048V127,
+		},
+		{
+			TestCaseName: "CAT048 with BDS",
+			input:        "fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+			uap:          uap.Cat048V127,
+		},
+		{
+			TestCaseName: "CAT062",
+			input:        "bf5ffd0304090001532100008e6f3e0017d0961247f10b7086fed3019a0fc8e301010c87304a04e072c34820e300820800eb003104b2190301487fa0ff0614ffffffffffff0493110101c006061414141400e0045b00e00182dc622931a410a800e00fc84010e001622b05010d01622902fea60177",
+			uap:          uap.Cat062V119,
+		},
+		{
+			TestCaseName: "Cat4Test conditional track",
+			input:        "01 38 80ff ffff",
+			uap:          uap.Cat4Test,
+		},
+		{
+			TestCaseName: "Cat4Test with RFS",
+			input:        "fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff",
+			uap:          uap.Cat4Test,
+		},
+		{
+			TestCaseName: "Cat4Test without RFS",
+			input:        "f9 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 03ffff",
+			uap:          uap.Cat4Test,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		decoded := NewRecord()
+		_, err := decoded.Decode(data, row.uap)
+		if err != nil {
+			t.Fatalf("FAIL: %s decoding error: %v", row.TestCaseName, err)
+		}
+		items := stripItems(decoded.Items)
+		rec := NewRecord()
+
+		// Act
+		err = rec.Encode(row.uap, items)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s error: %v; Expected: %v", row.TestCaseName, err, nil)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+		}
+		if bytes.Equal(rec.Payload(), data) == false {
+			t.Errorf("FAIL: %s payload = % X; Expected: % X", row.TestCaseName, rec.Payload(), data)
+		} else {
+			t.Logf("SUCCESS: payload = % X; Expected: % X", rec.Payload(), data)
+		}
+	}
+}
+
+func TestRecord_EncodeDecode_CAT001(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		items        []Item
+		output       string
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT001 plot",
+			items: []Item{
+				{Meta: MetaItem{FRN: 1}, Fixed: &Fixed{Data: []byte{0x08, 0x31}}},
+				{Meta: MetaItem{FRN: 2}, Extended: &Extended{Primary: []byte{0x00}}},
+				{Meta: MetaItem{FRN: 15}, Fixed: &Fixed{Data: []byte{0x05}}},
+				{Meta: MetaItem{FRN: 20}, SP: &SpecialPurpose{Data: []byte{0xaa}}},
+			},
+			output: "c10184 0831 00 05 02aa",
+		},
+		{
+			TestCaseName: "CAT001 track",
+			items: []Item{
+				{Meta: MetaItem{FRN: 1}, Fixed: &Fixed{Data: []byte{0x08, 0x31}}},
+				{Meta: MetaItem{FRN: 2}, Extended: &Extended{Primary: []byte{0x80}}},
+				{Meta: MetaItem{FRN: 3}, Fixed: &Fixed{Data: []byte{0x01, 0x23}}},
+				{Meta: MetaItem{FRN: 20}, SP: &SpecialPurpose{Data: []byte{0xaa}}},
+				{Meta: MetaItem{FRN: 22}, Fixed: &Fixed{Data: []byte{0x05}}},
+			},
+			output: "e1010580 0831 80 0123 02aa 05",
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		output, _ := util.HexStringToByte(row.output)
+		rec := NewRecord()
+		decoded := NewRecord()
+
+		// Act
+		err := rec.Encode(uap.Cat001V12, row.items)
+		unRead, errDecode := decoded.Decode(rec.Payload(), uap.Cat001V12)
+
+		// Assert
+		if err != nil || errDecode != nil {
+			t.Errorf("FAIL: %s error: %v, %v; Expected: %v", row.TestCaseName, err, errDecode, nil)
+		} else {
+			t.Logf("SUCCESS: error: %v, %v; Expected: %v", err, errDecode, nil)
+		}
+		if bytes.Equal(rec.Payload(), output) == false {
+			t.Errorf("FAIL: %s payload = % X; Expected: % X", row.TestCaseName, rec.Payload(), output)
+		} else {
+			t.Logf("SUCCESS: payload = % X; Expected: % X", rec.Payload(), output)
+		}
+		if unRead != 0 || bytes.Equal(decoded.Payload(), output) == false {
+			t.Errorf("FAIL: %s unRead = %d, decoded = % X; Expected: %d, % X", row.TestCaseName, unRead, decoded.Payload(), 0, output)
+		} else {
+			t.Logf("SUCCESS: unRead = %d, decoded = % X; Expected: %d, % X", unRead, decoded.Payload(), 0, output)
+		}
+	}
+}
+
+func TestRecord_EncodeByDataItem(t *testing.T) {
+	// Arrange
+	items := []Item{
+		{
+			Meta:  MetaItem{DataItem: "I048/140"},
+			Fixed: &Fixed{Data: []byte{0x42, 0x9b, 0x52}},
+		},
+		{
+			Meta:  MetaItem{DataItem: "I048/010"},
+			Fixed: &Fixed{Data: []byte{0x08, 0x36}},
+		},
+		{
+			Meta: MetaItem{DataItem: "I048/130"},
+			Compound: &Compound{
+				Secondary: []Item{
+					{Meta: MetaItem{DataItem: "SAM"}, Fixed: &Fixed{Data: []byte{0xb7}}},
+					{Meta: MetaItem{DataItem: "SRL"}, Fixed: &Fixed{Data: []byte{0x02}}},
+				},
+			},
+		},
+		{
+			Meta:     MetaItem{DataItem: "I048/020"},
+			Extended: &Extended{Primary: []byte{0xa0}},
+		},
+		{
+			Meta:       MetaItem{DataItem: "I048/250"},
+			Repetitive: &Repetitive{Data: []byte{0xc0, 0x60, 0xa3, 0x28, 0x00, 0x30, 0xa4, 0x00}},
+		},
+	}
+	output, _ := util.HexStringToByte("e320 0836 429b52 a0 a0 02 b7 01 c060a3280030a400")
+
+	// Act
+	rec := NewRecord()
+	err := rec.Encode(uap.Cat048V127, items)
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error: %v; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+	}
+	if bytes.Equal(rec.Payload(), output) == false {
+		t.Errorf("FAIL: payload = % X; Expected: % X", rec.Payload(), output)
+	} else {
+		t.Logf("SUCCESS: payload = % X; Expected: % X", rec.Payload(), output)
+	}
+	if rec.Items[0].Meta.Description != "Data Source Identifier" {
+		t.Errorf("FAIL: description = %s; Expected: %s", rec.Items[0].Meta.Description, "Data Source Identifier")
+	}
+}
+
+func TestRecord_EncodeError(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		items        []Item
+		err          error
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "unknown FRN",
+			items:        []Item{{Meta: MetaItem{FRN: 30}, Fixed: &Fixed{Data: []byte{0x00}}}},
+			err:          ErrItemUnknown,
+		},
+		{
+			TestCaseName: "unknown DataItem",
+			items:        []Item{{Meta: MetaItem{DataItem: "I048/999"}, Fixed: &Fixed{Data: []byte{0x00}}}},
+			err:          ErrItemUnknown,
+		},
+		{
+			TestCaseName: "compound spare FRN",
+			items: []Item{
+				{Meta: MetaItem{FRN: 20}, Compound: &Compound{Secondary: []Item{{Meta: MetaItem{FRN: 3}, Fixed: &Fixed{Data: []byte{0x00}}}}}},
+			},
+			err: ErrItemUnknown,
+		},
+		{
+			TestCaseName: "fixed undersized",
+			items:        []Item{{Meta: MetaItem{FRN: 1}, Fixed: &Fixed{Data: []byte{0x00}}}},
+			err:          ErrItemMalformed,
+		},
+		{
+			TestCaseName: "type mismatch",
+			items:        []Item{{Meta: MetaItem{FRN: 1}, Explicit: &Explicit{Data: []byte{0x00, 0x00}}}},
+			err:          ErrItemMalformed,
+		},
+		{
+			TestCaseName: "extended with secondary parts",
+			items:        []Item{{Meta: MetaItem{FRN: 3}, Extended: &Extended{Primary: []byte{0x00}, Secondary: []byte{0x00, 0x00}}}},
+			err:          nil,
+		},
+		{
+			TestCaseName: "repetitive size",
+			items:        []Item{{Meta: MetaItem{FRN: 10}, Repetitive: &Repetitive{Data: []byte{0x00, 0x00}}}},
+			err:          ErrItemMalformed,
+		},
+		{
+			TestCaseName: "duplicated",
+			items: []Item{
+				{Meta: MetaItem{FRN: 1}, Fixed: &Fixed{Data: []byte{0x00, 0x01}}},
+				{Meta: MetaItem{DataItem: "I048/010"}, Fixed: &Fixed{Data: []byte{0x00, 0x01}}},
+			},
+			err: ErrItemDuplicated,
+		},
+		{
+			TestCaseName: "compound sub-item unknown",
+			items: []Item{
+				{Meta: MetaItem{FRN: 7}, Compound: &Compound{Secondary: []Item{{Meta: MetaItem{FRN: 8}, Fixed: &Fixed{Data: []byte{0x00}}}}}},
+			},
+			err: ErrItemUnknown,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		rec := NewRecord()
+
+		// Act
+		err := rec.Encode(uap.Cat048V127, row.items)
+
+		// Assert
+		if err != row.err {
+			t.Errorf("FAIL: %s error: %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
+		}
+	}
+}
+
+func TestExtended_EncodeFX(t *testing.T) {
+	// Arrange
+	input := &Extended{Primary: []byte{0xf0, 0x00}, Secondary: []byte{0x01, 0x00, 0x02, 0x03}}
+	output := []byte{0xf0, 0x01, 0x01, 0x01, 0x02, 0x02}
+
+	// Act
+	e, err := encodeExtended(input, uap.ExtendedField{PrimarySize: 2, SecondarySize: 2})
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error: %v; Expected: %v", err, nil)
+	}
+	if bytes.Equal(e.Payload(), output) == false {
+		t.Errorf("FAIL: extended = % X; Expected: % X", e.Payload(), output)
+	} else {
+		t.Logf("SUCCESS: extended = % X; Expected: % X", e.Payload(), output)
+	}
+	if input.Secondary[3] != 0x03 {
+		t.Errorf("FAIL: input modified = % X", input.Secondary)
+	}
+}
+
+func TestDataBlock_Encode(t *testing.T) {
+	// Arrange
+	input := "300118fff7020836429b52a094c70181091302d06002b7490d0138a178cf422002e79a5d27a00c0060a3280030a4000040063a0743ce5b4020f5fff7020836429b54e000bc020901a2005c7802e800263946e50464b1cb6ca0029ea9491062a4546093880032d4000040059602f639590220f5fff7020836429b58a0909703ff026405a26002bb4066740815f6e795e002e56a0530ffdff860b0d80032fc00004003cf0810c9ef4020fdfff7020836429b56a0775d03700ec205786002be4060910815f9c363a002a49a0f30bfffff60c4600030a4000040057207674a004020fdfff7020836429b55a0468c029804b105786002c57101124d6070d3282002adfa3333a0140060c4600030a4000040026e07d75fc04020f5"
+	data, _ := util.HexStringToByte(input)
+	decoded := NewDataBlock()
+	_, _ = decoded.Decode(data)
+
+	var records []*Record
+	for _, r := range decoded.Records {
+		rec := NewRecord()
+		if err := rec.Encode(uap.Cat048V127, stripItems(r.Items)); err != nil {
+			t.Fatalf("FAIL: error: %v", err)
+		}
+		records = append(records, rec)
+	}
+
+	// Act
+	db := NewDataBlock()
+	err := db.Encode(48, records)
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error: %v; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+	}
+	if db.Len != 0x0118 {
+		t.Errorf("FAIL: len = %v; Expected: %v", db.Len, 0x0118)
+	} else {
+		t.Logf("SUCCESS: len = %v; Expected: %v", db.Len, 0x0118)
+	}
+	if bytes.Equal(bytes.Join(db.Payload(), nil), data) == false {
+		t.Errorf("FAIL: payload = % X; Expected: % X", db.Payload(), data)
+	} else {
+		t.Logf("SUCCESS: payload = % X; Expected: % X", db.Payload(), data)
+	}
+}
+
+func TestDataBlock_EncodeError(t *testing.T) {
+	// Arrange
+	rec := NewRecord()
+	_ = rec.Encode(uap.Cat048V127, []Item{{Meta: MetaItem{FRN: 1}, Fixed: &Fixed{Data: []byte{0x08, 0x36}}}})
+	big := NewRecord()
+	_ = big.Encode(uap.Cat048V127, []Item{{Meta: MetaItem{FRN: 10}, Repetitive: &Repetitive{Data: make([]byte, 8*255)}}})
+	var bigRecords []*Record
+	for i := 0; i < 33; i++ {
+		bigRecords = append(bigRecords, big)
+	}
+
+	// Act
+	errCat := NewDataBlock().Encode(34, []*Record{rec})
+	errLen := NewDataBlock().Encode(48, bigRecords)
+
+	// Assert
+	if errCat != ErrCategoryMismatch {
+		t.Errorf("FAIL: error: %v; Expected: %v", errCat, ErrCategoryMismatch)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", errCat, ErrCategoryMismatch)
+	}
+	if errLen != ErrOversized {
+		t.Errorf("FAIL: error: %v; Expected: %v", errLen, ErrOversized)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", errLen, ErrOversized)
+	}
+}
@@ -0,0 +1,179 @@
+package goasterix
+
+import (
+	"fmt"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// DecodeError describes where the decoding of asterix data failed.
+// Category is the category of the data block and Record the index of the faulty record in the data block.
+// FRN and DataItem identify the faulty item, they are empty when the error is not related to an item
+// (e.g. FSPEC or data block header).
+// Offset is the position in bytes of the faulty element in the decoded buffer.
+// Expected is the number of bytes needed by the faulty element (at least), Available is the number of bytes left.
+// Err is the cause, e.g. io.EOF, io.ErrUnexpectedEOF, ErrUndersized, ErrCategoryUnknown.
+type DecodeError struct {
+	Category  uint8
+	Record    int
+	FRN       uint8
+	DataItem  string
+	Offset    int
+	Expected  int
+	Available int
+	Err       error
+}
+
+func (e *DecodeError) Error() string {
+	str := fmt.Sprintf("[ASTERIX] category %d, record %d", e.Category, e.Record)
+	if e.FRN != 0 {
+		str = str + fmt.Sprintf(", FRN %d (%s)", e.FRN, e.DataItem)
+	}
+	str = str + fmt.Sprintf(", offset %d", e.Offset)
+	if e.Expected != 0 {
+		str = str + fmt.Sprintf(", expected %d bytes, available %d", e.Expected, e.Available)
+	}
+	return str + ": " + e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// itemLength returns the number of bytes of an item of data according to its data field.
+// The items of the UAP are used to get the length of the fields of a RFS item.
+// When data is too short to determine it, it returns the number of bytes needed at least and false.
+// It also returns false when a length indicator is 0, see zeroLen.
+func itemLength(data []byte, field uap.DataField, items []uap.DataField) (int, bool) {
+	switch field.Type {
+	case uap.Fixed:
+		n := int(field.Fixed.Size)
+		return n, len(data) >= n
+
+	case uap.Extended:
+		n := int(field.Extended.PrimarySize)
+		if n == 0 || len(data) < n {
+			return n, false
+		}
+		for data[n-1]&0x01 != 0 {
+			if field.Extended.SecondarySize == 0 {
+				return n, false
+			}
+			n += int(field.Extended.SecondarySize)
+			if len(data) < n {
+				return n, false
+			}
+		}
+		return n, true
+
+	case uap.RepetitiveFX:
+		size := int(field.Repetitive.SubItemSize)
+		if size == 0 {
+			return 0, false
+		}
+		n := size
+		for {
+			if len(data) < n {
+				return n, false
+			}
+			if data[n-1]&0x01 == 0 {
+				return n, true
+			}
+			n += size
+		}
+
+	case uap.Explicit, uap.SP, uap.RE:
+		if len(data) < 1 {
+			return 1, false
+		}
+		n := int(data[0])
+		if n == 0 {
+			return 1, false // LEN includes its own octet
+		}
+		return n, len(data) >= n
+
+	case uap.Repetitive:
+		if len(data) < 1 {
+			return 1, false
+		}
+		if field.Repetitive.Compound != nil {
+			n := 1
+			group := uap.DataField{Type: uap.Compound, Compound: field.Repetitive.Compound}
+			for i := 0; i < int(data[0]); i++ {
+				sub, ok := itemLength(data[n:], group, nil)
+				n += sub
+				if !ok {
+					return n, false
+				}
+			}
+			return n, true
+		}
+		n := 1 + int(data[0])*int(field.Repetitive.SubItemSize)
+		return n, len(data) >= n
+
+	case uap.Compound:
+		n := fspecLength(data)
+		if n == 0 || data[n-1]&0x01 != 0 {
+			return n + 1, false
+		}
+		if n > maxFspecLength {
+			return n, false
+		}
+		for _, frn := range FspecIndex(data[:n]) {
+			if int(frn) > len(field.Compound) || field.Compound[frn-1].Type == uap.Spare {
+				return n, false
+			}
+			sub, ok := itemLength(data[n:], field.Compound[frn-1], nil)
+			n += sub
+			if !ok {
+				return n, false
+			}
+		}
+		return n, true
+
+	case uap.RFS:
+		if len(data) < 1 {
+			return 1, false
+		}
+		n := 1
+		for i := 0; i < int(data[0]); i++ {
+			if len(data) < n+1 {
+				return n + 1, false
+			}
+			rf, found := lookupRandomField(items, data[n])
+			n++
+			if !found {
+				return n, false
+			}
+			sub, ok := itemLength(data[n:], rf, nil)
+			n += sub
+			if !ok {
+				return n, false
+			}
+		}
+		return n, true
+	}
+	return 0, false
+}
+
+// fspecLength returns the number of octets of the FSPEC (or primary subfield) at the beginning of data.
+func fspecLength(data []byte) int {
+	n := 0
+	for n < len(data) {
+		n++
+		if data[n-1]&0x01 == 0 {
+			break
+		}
+	}
+	return n
+}
+
+// zeroLen returns true if data starts with a length indicator of 0 for an Explicit, SP or RE data field,
+// which is invalid as the length indicator includes its own octet.
+func zeroLen(data []byte, field uap.DataField) bool {
+	switch field.Type {
+	case uap.Explicit, uap.SP, uap.RE:
+		return len(data) > 0 && data[0] == 0
+	}
+	return false
+}
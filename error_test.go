@@ -0,0 +1,175 @@
+package goasterix
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestDecodeError(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		output       DecodeError
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT255 + CAT048 item truncated",
+			input:        "ff000ae008837e019d58 300007c0083642",
+			output: DecodeError{
+				Category: 48, Record: 0, FRN: 2, DataItem: "I048/140",
+				Offset: 16, Expected: 3, Available: 1, Err: io.ErrUnexpectedEOF,
+			},
+		},
+		{
+			TestCaseName: "CAT255 + CAT255 second record FSPEC truncated",
+			input:        "ff000ae008837e019d58 ff000be008837e019d5881",
+			output: DecodeError{
+				Category: 255, Record: 1, Offset: 20, Expected: 2, Available: 1, Err: io.EOF,
+			},
+		},
+		{
+			TestCaseName: "CAT255 + category unknown",
+			input:        "ff000ae008837e019d58 000005ffff",
+			output:       DecodeError{Category: 0, Record: 0, Offset: 13, Err: ErrCategoryUnknown},
+		},
+		{
+			TestCaseName: "CAT255 + data block undersized",
+			input:        "ff000ae008837e019d58 ff000be008837e019d",
+			output:       DecodeError{Category: 255, Record: -1, Offset: 10, Expected: 11, Available: 9, Err: ErrUndersized},
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		w, _ := NewWrapperDataBlock()
+
+		// Act
+		_, err := w.Decode(data)
+
+		// Assert
+		var de *DecodeError
+		if !errors.As(err, &de) {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, &row.output)
+			continue
+		}
+		if *de != row.output {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, de, &row.output)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", de, &row.output)
+		}
+		if !errors.Is(err, row.output.Err) {
+			t.Errorf("FAIL: %s errors.Is(%v) = false; Expected: true", row.TestCaseName, row.output.Err)
+		}
+	}
+}
+
+func TestItemLength(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		field        uap.DataField
+		length       int
+		ok           bool
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "fixed",
+			input:        "0102",
+			field:        uap.DataField{Type: uap.Fixed, Fixed: uap.FixedField{Size: 2}},
+			length:       2,
+			ok:           true,
+		},
+		{
+			TestCaseName: "fixed truncated",
+			input:        "01",
+			field:        uap.DataField{Type: uap.Fixed, Fixed: uap.FixedField{Size: 2}},
+			length:       2,
+			ok:           false,
+		},
+		{
+			TestCaseName: "extended",
+			input:        "0103 0101 0000 ff",
+			field:        uap.DataField{Type: uap.Extended, Extended: uap.ExtendedField{PrimarySize: 2, SecondarySize: 2}},
+			length:       6,
+			ok:           true,
+		},
+		{
+			TestCaseName: "extended truncated",
+			input:        "0103 01",
+			field:        uap.DataField{Type: uap.Extended, Extended: uap.ExtendedField{PrimarySize: 2, SecondarySize: 2}},
+			length:       4,
+			ok:           false,
+		},
+		{
+			TestCaseName: "explicit",
+			input:        "03 0102 ff",
+			field:        uap.DataField{Type: uap.Explicit},
+			length:       3,
+			ok:           true,
+		},
+		{
+			TestCaseName: "repetitive",
+			input:        "02 0102 0304",
+			field:        uap.DataField{Type: uap.Repetitive, Repetitive: uap.RepetitiveField{SubItemSize: 2}},
+			length:       5,
+			ok:           true,
+		},
+		{
+			TestCaseName: "repetitive truncated",
+			input:        "02 0102",
+			field:        uap.DataField{Type: uap.Repetitive, Repetitive: uap.RepetitiveField{SubItemSize: 2}},
+			length:       5,
+			ok:           false,
+		},
+		{
+			TestCaseName: "compound",
+			input:        "6002b7 ff",
+			field:        uap.Cat048V127.Items[6],
+			length:       3,
+			ok:           true,
+		},
+		{
+			TestCaseName: "compound primary truncated",
+			input:        "61",
+			field:        uap.Cat048V127.Items[6],
+			length:       2,
+			ok:           false,
+		},
+		{
+			TestCaseName: "RFS",
+			input:        "02 03 a140 0b 0001 ff",
+			field:        uap.DataField{Type: uap.RFS},
+			length:       7,
+			ok:           true,
+		},
+		{
+			TestCaseName: "RFS FRN unknown",
+			input:        "01 1f 00",
+			field:        uap.DataField{Type: uap.RFS},
+			length:       2,
+			ok:           false,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+
+		// Act
+		length, ok := itemLength(data, row.field, uap.Cat048V127.Items)
+
+		// Assert
+		if length != row.length || ok != row.ok {
+			t.Errorf("FAIL: %s length = %v, %v; Expected: %v, %v", row.TestCaseName, length, ok, row.length, row.ok)
+		} else {
+			t.Logf("SUCCESS: length = %v, %v; Expected: %v, %v", length, ok, row.length, row.ok)
+		}
+	}
+}
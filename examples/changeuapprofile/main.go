@@ -21,13 +21,13 @@ func main() {
 		"30 003a fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
 	}
 
-	// change User Application Profile STR by UAP ARTAS V7.0
-	uap.DefaultProfiles[30] = uap.Cat030ArtasV62
+	// change User Application Profile STR by UAP ARTAS V6.2 for this decoder only
+	decoder := goasterix.NewDecoder()
+	decoder.SetProfile(uap.Cat030ArtasV62)
 
 	for _, data := range dataSet {
-		w, _ := goasterix.NewWrapperDataBlock()
 		tmp, _ := util.HexStringToByte(data)
-		_, err := w.Decode(tmp) // data contains a set of DataBlocks
+		w, _, err := decoder.DecodeWrapper(tmp) // data contains a set of DataBlocks
 		if err != nil {
 			fmt.Println("ERROR Wrapper: ", err)
 		}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"fmt"
+	"os"
+
 	"github.com/mokhtarimokhtar/goasterix"
+	"github.com/mokhtarimokhtar/goasterix/uap"
 	"github.com/mokhtarimokhtar/goasterix/util"
 )
 
@@ -30,13 +33,11 @@ func main() {
 
 		for _, dataBlock := range w.DataBlocks {
 			// dataBlock contains one datablock = CAT + LEN + RECORD(S)
-			fmt.Printf("\nCategory: %v, Len: %v\n", dataBlock.Category, dataBlock.Len)
-			for i, records := range dataBlock.String() {
-				// records contains one or more records = N * items
-				fmt.Println("Record: ", i+1)
-				for _, record := range records {
-					fmt.Println(record) // it displays its items in Hexadecimal
-				}
+			// each record is displayed as a tree of its items with their description
+			fmt.Println()
+			err = dataBlock.Dump(os.Stdout, uap.DefaultProfiles[dataBlock.Category], goasterix.DumpOptions{})
+			if err != nil {
+				fmt.Println("ERROR Dump: ", err)
 			}
 		}
 	}
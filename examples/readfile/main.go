@@ -3,23 +3,32 @@ package main
 import (
 	"fmt"
 	"github.com/mokhtarimokhtar/goasterix"
-	"io/ioutil"
+	"io"
 	"log"
+	"os"
 )
 
 func main() {
-	data, err := ioutil.ReadFile("../data/sample.ast")
+	f, err := os.Open("../data/sample.ast")
 	if err != nil {
 		log.Fatalln(err)
 	}
+	defer f.Close()
 
-	w := new(goasterix.WrapperDataBlock) // wrapper of asterix datablock, it contains one or more datablocks
-	_, err = w.Decode(data)              // decode method one or more datablocks
-	if err != nil {
-		fmt.Println("ERROR Wrapper: ", err)
-	}
+	r := goasterix.NewReader(f) // reader of asterix datablock, it reads one datablock at a time
+	for {
+		dataB, err := r.Next() // decode method the next datablock
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Println("ERROR Reader: ", err)
+			if dataB == nil {
+				break // the stream is truncated or unreadable
+			}
+			continue
+		}
 
-	for _, dataB := range w.DataBlocks {
 		// dataBlock contains one datablock = CAT + LEN + RECORD(S)
 		fmt.Printf("Category: %v, Len: %v\n", dataB.Category, dataB.Len)
 		for i, records := range dataB.String() {
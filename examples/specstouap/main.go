@@ -0,0 +1,51 @@
+// Command specstouap converts a category definition of asterix-specs (JSON) to a UAP file
+// which can be loaded by goasterix (uap.LoadFile, Decoder.LoadProfile).
+//
+//	specstouap -format yaml cat048-1.31.json > cat048_1.31.yaml
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/mokhtarimokhtar/goasterix/specs"
+	"gopkg.in/yaml.v3"
+)
+
+func main() {
+	format := flag.String("format", "yaml", "format of the UAP file: json, yaml or xml")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: specstouap [-format json|yaml|xml] cat048-1.31.json")
+		os.Exit(2)
+	}
+
+	cat, err := specs.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	stdUAP, err := cat.StandardUAP()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var data []byte
+	switch *format {
+	case "json":
+		data, err = json.MarshalIndent(stdUAP, "", "  ")
+	case "yaml":
+		data, err = yaml.Marshal(stdUAP)
+	case "xml":
+		data, err = xml.MarshalIndent(stdUAP, "", "  ")
+	default:
+		log.Fatalln("format unknown:", *format)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+	fmt.Println(string(data))
+}
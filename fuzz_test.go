@@ -0,0 +1,137 @@
+package goasterix
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+// fuzzSeeds are valid data blocks mutated by the fuzz tests.
+var fuzzSeeds = []string{
+	// cat 048
+	"30 003a fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+	// cat 026 (Cat4Test): all types of items
+	"1a 0029 fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff",
+	// cat 062
+	"3e 000f 81010108 0836 010203 040506",
+	// cat 001 + cat 002
+	"01005cf52208329800bb224db58001ee3b10c9813f896e0075068801c60946b0940141d5f0081075229801c90d93b06c015aa530c18155815800752298010f0505b54c01ab9e84818154007507088801c803b9b81c014ab2d40c108202000cf4083202b83aac9722",
+	// cat 255
+	"ff000ae008833aad7358",
+	// cat 048: FSPEC of 37 octets, the FRN 256 does not fit in an uint8
+	"30 002b 010101010101010101010101010101010101010101010101010101010101010101010101 10 ffffff",
+}
+
+// addSeeds adds the fuzzSeeds to the seed corpus of f.
+func addSeeds(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		data, err := util.HexStringToByte(seed)
+		if err != nil {
+			f.Fatalf("FAIL: seed %s - err = %v; Expected: %v", seed, err, nil)
+		}
+		f.Add(data)
+	}
+}
+
+func FuzzWrapperDataBlockDecode(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		w, _ := NewWrapperDataBlock()
+		_, _ = w.Decode(data)
+
+		lenient, _ := NewWrapperDataBlock()
+		_ = lenient.DecodeLenient(data)
+
+		parallel, _ := NewWrapperDataBlock()
+		_, _ = parallel.DecodeParallel(context.Background(), data, 2)
+	})
+}
+
+func FuzzDataBlockDecodeNoCopy(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		db := new(DataBlock)
+		_, _ = db.DecodeNoCopy(data)
+	})
+}
+
+func FuzzDataBlockValidate(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		db := new(DataBlock)
+		_, _ = db.Decode(data)
+		_ = db.Validate(uap.DefaultProfiles[db.Category])
+	})
+}
+
+func FuzzRecordDecodeItems(f *testing.F) {
+	addSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) < 3 {
+			return
+		}
+		rec := new(Record)
+		_, _ = rec.DecodeItems(data[3:], uap.Cat4Test, "I026/003", "I026/005", "SP")
+	})
+}
+
+func TestExplicitDataFieldReader_ZeroLen(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name  string
+		input string
+		uap   uap.StandardUAP
+		err   error
+	}
+	dataSet := []testCase{
+		{
+			Name:  "testcase 1: Explicit LEN 0",
+			input: "20 00 ffff",
+			uap:   uap.Cat4Test,
+			err:   ErrLenInvalid,
+		},
+		{
+			Name:  "testcase 2: SP LEN 0",
+			input: "01 40 00 ffff",
+			uap:   uap.Cat4Test,
+			err:   ErrLenInvalid,
+		},
+		{
+			Name:  "testcase 3: FSPEC exceeds the UAP",
+			input: "01 01 01 01 01 01 01 01 01 01 80 ff",
+			uap:   uap.Cat4Test,
+			err:   ErrFRNUnknown,
+		},
+		{
+			Name:  "testcase 4: FSPEC longer than 36 octets",
+			input: "010101010101010101010101010101010101010101010101010101010101010101010101 10 ffffff",
+			uap:   uap.Cat048V127,
+			err:   ErrFRNUnknown,
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		input, _ := util.HexStringToByte(tc.input)
+		rec := new(Record)
+		recNoCopy := new(Record)
+		recItems := new(Record)
+
+		// Act
+		_, err := rec.Decode(input, tc.uap)
+		_, errNoCopy := recNoCopy.DecodeNoCopy(input, tc.uap)
+		_, errItems := recItems.DecodeItems(input, tc.uap)
+
+		// Assert
+		for _, e := range []error{err, errNoCopy, errItems} {
+			decodeErr, ok := e.(*DecodeError)
+			if !ok || decodeErr.Err != tc.err {
+				t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, e, tc.err)
+			} else {
+				t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, e, tc.err)
+			}
+		}
+	}
+}
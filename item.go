@@ -13,14 +13,15 @@ type MetaItem struct {
 }
 
 type Item struct {
-	Meta       MetaItem
-	Fixed      *Fixed
-	Extended   *Extended
-	Explicit   *Explicit
-	Repetitive *Repetitive
-	Compound   *Compound
-	RFS        *RandomFieldSequencing
-	SP         *SpecialPurpose
+	Meta         MetaItem
+	Fixed        *Fixed
+	Extended     *Extended
+	Explicit     *Explicit
+	Repetitive   *Repetitive
+	RepetitiveFX *RepetitiveFX
+	Compound     *Compound
+	RFS          *RandomFieldSequencing
+	SP           *SpecialPurpose
 }
 
 func NewItem(field uap.DataField) *Item {
@@ -45,8 +46,14 @@ func (i *Item) Payload() []byte {
 		p = i.Explicit.Payload()
 	case uap.Repetitive:
 		p = i.Repetitive.Payload()
+	case uap.RepetitiveFX:
+		p = i.RepetitiveFX.Payload()
 	case uap.Compound:
 		p = i.Compound.Payload()
+	case uap.RFS:
+		p = i.RFS.Payload()
+	case uap.SP, uap.RE:
+		p = i.SP.Payload()
 	}
 	return p
 }
@@ -63,12 +70,59 @@ func (i *Item) String() string {
 		str = str + ": " + i.Explicit.String()
 	case uap.Repetitive:
 		str = str + ": " + i.Repetitive.String()
+	case uap.RepetitiveFX:
+		str = str + ": " + i.RepetitiveFX.String()
 	case uap.Compound:
 		str = str + ": " + i.Compound.String()
+	case uap.RFS:
+		str = str + ": " + i.RFS.String()
+	case uap.SP, uap.RE:
+		str = str + ": " + i.SP.String()
 	}
 	return str
 }
 
+// SubItem returns the sub-item of a Compound item identified by its DataItem (e.g. "ADR") and true,
+// or nil and false when the item is not a Compound or does not contain it.
+func (i *Item) SubItem(dataItem string) (*Item, bool) {
+	if i.Compound == nil {
+		return nil, false
+	}
+	for j := range i.Compound.Secondary {
+		if i.Compound.Secondary[j].Meta.DataItem == dataItem {
+			return &i.Compound.Secondary[j], true
+		}
+	}
+	return nil, false
+}
+
+// SubItemByFRN returns the sub-item of a Compound item identified by its FRN and true,
+// or nil and false when the item is not a Compound or does not contain it.
+func (i *Item) SubItemByFRN(frn uint8) (*Item, bool) {
+	if i.Compound == nil {
+		return nil, false
+	}
+	for j := range i.Compound.Secondary {
+		if i.Compound.Secondary[j].Meta.FRN == frn {
+			return &i.Compound.Secondary[j], true
+		}
+	}
+	return nil, false
+}
+
+// Uint64 returns the unsigned value (big endian) of the data of a Fixed item and true,
+// or 0 and false when the item is not Fixed or its data is longer than 8 bytes.
+func (i *Item) Uint64() (uint64, bool) {
+	if i.Fixed == nil || len(i.Fixed.Data) > 8 {
+		return 0, false
+	}
+	var v uint64
+	for _, b := range i.Fixed.Data {
+		v = v<<8 | uint64(b)
+	}
+	return v, true
+}
+
 type Fixed struct {
 	Data []byte
 }
@@ -99,9 +153,12 @@ func (e *Extended) String() string {
 	return hex.EncodeToString(e.Primary) + hex.EncodeToString(e.Secondary)
 }
 
+// Explicit is an explicit length item, Data is the contents following LEN.
+// When the contents is defined as a compound, Compound is its decoded view, Data still contains its bytes.
 type Explicit struct {
-	Len  uint8
-	Data []byte
+	Len      uint8
+	Data     []byte
+	Compound *Compound
 }
 
 func (e *Explicit) Payload() []byte {
@@ -116,9 +173,12 @@ func (e *Explicit) String() string {
 	return hex.EncodeToString(tmp) + hex.EncodeToString(e.Data)
 }
 
+// Repetitive is a repetitive item, Data is the contents of the REP repetitions.
+// When each repetition is defined as a compound, Compounds is their decoded view, Data still contains their bytes.
 type Repetitive struct {
-	Rep  uint8
-	Data []byte
+	Rep       uint8
+	Data      []byte
+	Compounds []Compound
 }
 
 func (r *Repetitive) Payload() []byte {
@@ -133,6 +193,31 @@ func (r *Repetitive) String() string {
 	return hex.EncodeToString(tmp) + hex.EncodeToString(r.Data)
 }
 
+// RepetitiveFX is a repetitive item whose repetitions are chained by the FX bit of their last octet,
+// Elements are the repetitions including their FX bit.
+type RepetitiveFX struct {
+	Elements [][]byte
+}
+
+func (r *RepetitiveFX) Payload() []byte {
+	var p []byte
+	for _, e := range r.Elements {
+		p = append(p, e...)
+	}
+	return p
+}
+
+func (r *RepetitiveFX) String() string {
+	var str string
+	for i, e := range r.Elements {
+		if i != 0 {
+			str = str + " "
+		}
+		str = str + hex.EncodeToString(e)
+	}
+	return str
+}
+
 type Compound struct {
 	Primary   []byte
 	Secondary []Item
@@ -162,12 +247,47 @@ type RandomFieldSequencing struct {
 	Sequence []RandomField
 }
 
+func (rfs *RandomFieldSequencing) Payload() []byte {
+	var p []byte
+	p = append(p, rfs.N)
+	for _, rf := range rfs.Sequence {
+		p = append(p, rf.FRN)
+		p = append(p, rf.Field.Payload()...)
+	}
+	return p
+}
+
+func (rfs RandomFieldSequencing) String() string {
+	var str string
+	str = "[N: " + hex.EncodeToString([]byte{rfs.N}) + "]"
+	for _, rf := range rfs.Sequence {
+		str = str + "[FRN: " + hex.EncodeToString([]byte{rf.FRN}) + " " + rf.Field.String() + "]"
+	}
+	return str
+}
+
 type RandomField struct {
 	FRN   uint8
 	Field Item
 }
 
+// SpecialPurpose is the contents of a SP or RE field, Data is the field without its LEN octet.
+// Items is filled by the sub-decoder of the field, see Decoder.SetSPDecoder, Err is the error of this sub-decoder.
 type SpecialPurpose struct {
-	Len  uint8
-	Data []byte
+	Len   uint8
+	Data  []byte
+	Items []Item
+	Err   error `json:"-" xml:"-"`
+}
+
+func (sp *SpecialPurpose) Payload() []byte {
+	var p []byte
+	p = append(p, sp.Len)
+	p = append(p, sp.Data...)
+	return p
+}
+
+func (sp *SpecialPurpose) String() string {
+	tmp := []byte{sp.Len}
+	return hex.EncodeToString(tmp) + hex.EncodeToString(sp.Data)
 }
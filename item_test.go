@@ -272,6 +272,43 @@ func TestItem_Payload(t *testing.T) {
 			output: []byte{0xc0, 0xff, 0xff, 0xff, 0xff},
 			len:    5,
 		},
+		{
+			TestCaseName: "testcase 6",
+			input: Item{
+				Meta: MetaItem{
+					FRN:         1,
+					DataItem:    "SP",
+					Description: "Test item",
+					Type:        uap.SP,
+				},
+				SP: &SpecialPurpose{
+					Len:  0x03,
+					Data: []byte{0xff, 0xfe},
+				},
+			},
+			output: []byte{0x03, 0xff, 0xfe},
+			len:    3,
+		},
+		{
+			TestCaseName: "testcase 7",
+			input: Item{
+				Meta: MetaItem{
+					FRN:         6,
+					DataItem:    "RFS",
+					Description: "Test item",
+					Type:        uap.RFS,
+				},
+				RFS: &RandomFieldSequencing{
+					N: 0x02,
+					Sequence: []RandomField{
+						{FRN: 1, Field: Item{Meta: MetaItem{Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0xff, 0xfe}}}},
+						{FRN: 3, Field: Item{Meta: MetaItem{Type: uap.Extended}, Extended: &Extended{Primary: []byte{0x01}, Secondary: []byte{0x02}}}},
+					},
+				},
+			},
+			output: []byte{0x02, 0x01, 0xff, 0xfe, 0x03, 0x01, 0x02},
+			len:    7,
+		},
 	}
 	for _, row := range dataSet {
 		// Arrange
@@ -418,3 +455,102 @@ func TestItem_String(t *testing.T) {
 		}
 	}
 }
+
+func TestItem_SubItemByFRN(t *testing.T) {
+	// Arrange
+	item := Item{
+		Meta: MetaItem{FRN: 5, DataItem: "I026/005", Type: uap.Compound},
+		Compound: &Compound{
+			Primary: []byte{0x80},
+			Secondary: []Item{
+				{Meta: MetaItem{FRN: 1, DataItem: "Compound/001", Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0xab}}},
+			},
+		},
+	}
+
+	// Act
+	sub, found := item.SubItemByFRN(1)
+	_, notFound := item.SubItemByFRN(3)
+
+	// Assert
+	if found == false || sub.Meta.DataItem != "Compound/001" || notFound == true {
+		t.Errorf("FAIL: found = %v, notFound = %v; Expected: %v, %v", found, notFound, true, false)
+	} else {
+		t.Logf("SUCCESS: found = %v, notFound = %v; Expected: %v, %v", found, notFound, true, false)
+	}
+}
+
+func TestItem_Uint64(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		item         Item
+		ok           bool
+		output       uint64
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "fixed 2 bytes",
+			item:         Item{Meta: MetaItem{Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0x08, 0x36}}},
+			ok:           true,
+			output:       0x0836,
+		},
+		{
+			TestCaseName: "fixed 8 bytes",
+			item:         Item{Meta: MetaItem{Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}}},
+			ok:           true,
+			output:       0x0102030405060708,
+		},
+		{
+			TestCaseName: "fixed 9 bytes",
+			item:         Item{Meta: MetaItem{Type: uap.Fixed}, Fixed: &Fixed{Data: make([]byte, 9)}},
+			ok:           false,
+			output:       0,
+		},
+		{
+			TestCaseName: "not fixed",
+			item:         Item{Meta: MetaItem{Type: uap.Explicit}, Explicit: &Explicit{Len: 2, Data: []byte{0x01}}},
+			ok:           false,
+			output:       0,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		// Act
+		v, ok := row.item.Uint64()
+
+		// Assert
+		if ok != row.ok || v != row.output {
+			t.Errorf("FAIL: %s value = %x, ok = %v; Expected: %x, %v", row.TestCaseName, v, ok, row.output, row.ok)
+		} else {
+			t.Logf("SUCCESS: value = %x, ok = %v; Expected: %x, %v", v, ok, row.output, row.ok)
+		}
+	}
+}
+
+func TestRepetitiveFX_PayloadString(t *testing.T) {
+	// Arrange
+	item := Item{
+		Meta:         MetaItem{DataItem: "I062/510", Type: uap.RepetitiveFX},
+		RepetitiveFX: &RepetitiveFX{Elements: [][]byte{{0x01, 0x02, 0x03}, {0x04, 0x05, 0x06}}},
+	}
+	payload := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06}
+	str := "I062/510: 010203 040506"
+
+	// Act
+	p := item.Payload()
+	s := item.String()
+
+	// Assert
+	if bytes.Equal(p, payload) == false {
+		t.Errorf("FAIL: payload = %x; Expected: %x", p, payload)
+	} else {
+		t.Logf("SUCCESS: payload = %x; Expected: %x", p, payload)
+	}
+	if s != str {
+		t.Errorf("FAIL: string = %v; Expected: %v", s, str)
+	} else {
+		t.Logf("SUCCESS: string = %v; Expected: %v", s, str)
+	}
+}
@@ -0,0 +1,107 @@
+package goasterix
+
+// maxDataBlockSize is the maximum length of a data block given by its LEN field.
+const maxDataBlockSize = 0xFFFF
+
+// dataBlockLen returns the LEN field of a data block containing the records (CAT + LEN + records).
+// It returns ErrOversized when the data block exceeds the maximum length of LEN field.
+func dataBlockLen(records []*Record) (uint16, error) {
+	length := 3 // CAT + LEN
+	for _, rec := range records {
+		length += len(rec.Payload())
+	}
+	if length > maxDataBlockSize {
+		return 0, ErrOversized
+	}
+	return uint16(length), nil
+}
+
+// AppendBinary appends the payload of the record (FSPEC + items) to b and returns the extended buffer.
+func (rec *Record) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, rec.Fspec...)
+	for _, item := range rec.Items {
+		b = append(b, item.Payload()...)
+	}
+	return b, nil
+}
+
+// MarshalBinary returns the payload of the record (FSPEC + items) in one buffer.
+func (rec *Record) MarshalBinary() ([]byte, error) {
+	return rec.AppendBinary(nil)
+}
+
+// AppendBinary appends the data block (CAT + LEN + records) to b and returns the extended buffer.
+// The LEN field is computed from the records, db.Len is not used.
+// It returns ErrOversized when the data block exceeds the maximum length of LEN field.
+func (db *DataBlock) AppendBinary(b []byte) ([]byte, error) {
+	length, err := dataBlockLen(db.Records)
+	if err != nil {
+		return b, err
+	}
+	b = append(b, db.Category, byte(length>>8), byte(length))
+	for _, rec := range db.Records {
+		b, _ = rec.AppendBinary(b)
+	}
+	return b, nil
+}
+
+// MarshalBinary returns the data block (CAT + LEN + records) in one buffer, like AppendBinary.
+func (db *DataBlock) MarshalBinary() ([]byte, error) {
+	return db.AppendBinary(nil)
+}
+
+// Split returns the records of the data block grouped in data blocks of the same category,
+// each one of maxSize bytes at most (e.g. to fit in an UDP datagram), keeping the order of the records.
+// When maxSize is zero or exceeds the maximum length of LEN field, this maximum is used.
+// It returns ErrOversized when a record alone does not fit in maxSize.
+func (db *DataBlock) Split(maxSize int) ([]*DataBlock, error) {
+	if maxSize <= 0 || maxSize > maxDataBlockSize {
+		maxSize = maxDataBlockSize
+	}
+
+	var blocks []*DataBlock
+	var records []*Record
+	length := 3 // CAT + LEN
+	for _, rec := range db.Records {
+		size := len(rec.Payload())
+		if 3+size > maxSize {
+			return nil, ErrOversized
+		}
+		if length+size > maxSize {
+			blocks = append(blocks, db.split(records))
+			records = nil
+			length = 3
+		}
+		records = append(records, rec)
+		length += size
+	}
+	if len(records) != 0 {
+		blocks = append(blocks, db.split(records))
+	}
+	return blocks, nil
+}
+
+// split returns a data block of the category of db containing the records, which fit in a data block.
+func (db *DataBlock) split(records []*Record) *DataBlock {
+	length, _ := dataBlockLen(records)
+	return &DataBlock{Category: db.Category, Len: length, Records: records}
+}
+
+// AppendBinary appends the data blocks of the wrapper to b, each one like DataBlock.AppendBinary,
+// and returns the extended buffer.
+func (w *WrapperDataBlock) AppendBinary(b []byte) ([]byte, error) {
+	var err error
+	start := len(b)
+	for _, db := range w.DataBlocks {
+		b, err = db.AppendBinary(b)
+		if err != nil {
+			return b[:start], err
+		}
+	}
+	return b, nil
+}
+
+// MarshalBinary returns the data blocks of the wrapper in one buffer, like AppendBinary.
+func (w *WrapperDataBlock) MarshalBinary() ([]byte, error) {
+	return w.AppendBinary(nil)
+}
@@ -0,0 +1,197 @@
+package goasterix
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestDataBlock_MarshalBinary(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		edit         func(db *DataBlock)
+		err          error
+		output       string
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "decoded datablock",
+			input:        "300011 e0 0836 429b52 a0 e0 0836 429b52 a0",
+			edit:         func(db *DataBlock) {},
+			err:          nil,
+			output:       "300011 e0 0836 429b52 a0 e0 0836 429b52 a0",
+		},
+		{
+			TestCaseName: "LEN recomputed after editing",
+			input:        "300011 e0 0836 429b52 a0 e0 0836 429b52 a0",
+			edit:         func(db *DataBlock) { _ = db.Records[1].RemoveItem(2) },
+			err:          nil,
+			output:       "30000e e0 0836 429b52 a0 a0 0836 a0",
+		},
+		{
+			TestCaseName: "no record",
+			input:        "300011 e0 0836 429b52 a0 e0 0836 429b52 a0",
+			edit:         func(db *DataBlock) { db.Records = nil },
+			err:          nil,
+			output:       "300003",
+		},
+		{
+			TestCaseName: "oversized",
+			input:        "300011 e0 0836 429b52 a0 e0 0836 429b52 a0",
+			edit: func(db *DataBlock) {
+				large := &Record{
+					Cat:   48,
+					Fspec: []byte{0x80},
+					Items: []Item{{Meta: MetaItem{FRN: 1, Type: uap.Fixed}, Fixed: &Fixed{Data: make([]byte, 0xFFFF)}}},
+				}
+				db.Records = append(db.Records, large)
+			},
+			err:    ErrOversized,
+			output: "",
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		output, _ := util.HexStringToByte(row.output)
+		db := NewDataBlock()
+		_, _ = db.Decode(data)
+		row.edit(db)
+
+		// Act
+		b, err := db.MarshalBinary()
+
+		// Assert
+		if errors.Is(err, row.err) == false {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, row.err)
+		}
+		if bytes.Equal(b, output) == false {
+			t.Errorf("FAIL: %s data = %x; Expected: %x", row.TestCaseName, b, output)
+		} else {
+			t.Logf("SUCCESS: data = %x; Expected: %x", b, output)
+		}
+	}
+}
+
+func TestDataBlock_AppendBinary(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("30000a e0 0836 429b52 a0")
+	output, _ := util.HexStringToByte("ffff 30000a e0 0836 429b52 a0")
+	db := NewDataBlock()
+	_, _ = db.Decode(data)
+
+	// Act
+	b, err := db.AppendBinary([]byte{0xff, 0xff})
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error = %v; Expected: %v", err, nil)
+	}
+	if bytes.Equal(b, output) == false {
+		t.Errorf("FAIL: data = %x; Expected: %x", b, output)
+	} else {
+		t.Logf("SUCCESS: data = %x; Expected: %x", b, output)
+	}
+}
+
+func TestDataBlock_Split(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		maxSize      int
+		err          error
+		output       []string
+	}
+	input := "300018 e0 0836 429b52 a0 e0 0836 429b53 a0 e0 0836 429b54 a0"
+	dataSet := []dataTest{
+		{
+			TestCaseName: "max size of LEN field",
+			maxSize:      0,
+			err:          nil,
+			output:       []string{"300018 e0 0836 429b52 a0 e0 0836 429b53 a0 e0 0836 429b54 a0"},
+		},
+		{
+			TestCaseName: "two records per datablock",
+			maxSize:      20,
+			err:          nil,
+			output: []string{
+				"300011 e0 0836 429b52 a0 e0 0836 429b53 a0",
+				"30000a e0 0836 429b54 a0",
+			},
+		},
+		{
+			TestCaseName: "one record per datablock",
+			maxSize:      10,
+			err:          nil,
+			output: []string{
+				"30000a e0 0836 429b52 a0",
+				"30000a e0 0836 429b53 a0",
+				"30000a e0 0836 429b54 a0",
+			},
+		},
+		{
+			TestCaseName: "record too large",
+			maxSize:      9,
+			err:          ErrOversized,
+			output:       nil,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(input)
+		db := NewDataBlock()
+		_, _ = db.Decode(data)
+
+		// Act
+		blocks, err := db.Split(row.maxSize)
+
+		// Assert
+		if errors.Is(err, row.err) == false {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, row.err)
+		}
+		if len(blocks) != len(row.output) {
+			t.Errorf("FAIL: %s nb of datablocks = %v; Expected: %v", row.TestCaseName, len(blocks), len(row.output))
+			continue
+		}
+		for i, block := range blocks {
+			output, _ := util.HexStringToByte(row.output[i])
+			b, _ := block.MarshalBinary()
+			if bytes.Equal(b, output) == false || int(block.Len) != len(output) {
+				t.Errorf("FAIL: %s datablock %d = %x, LEN = %d; Expected: %x", row.TestCaseName, i, b, block.Len, output)
+			} else {
+				t.Logf("SUCCESS: datablock %d = %x; Expected: %x", i, b, output)
+			}
+		}
+	}
+}
+
+func TestWrapperDataBlock_MarshalBinary(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("300011 e0 0836 429b52 a0 e0 0836 429b52 a0 30000a e0 0836 429b53 a0")
+	w, _ := NewWrapperDataBlock()
+	_, _ = w.Decode(data)
+
+	// Act
+	b, err := w.MarshalBinary()
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error = %v; Expected: %v", err, nil)
+	}
+	if bytes.Equal(b, data) == false {
+		t.Errorf("FAIL: data = %x; Expected: %x", b, data)
+	} else {
+		t.Logf("SUCCESS: data = %x; Expected: %x", b, data)
+	}
+}
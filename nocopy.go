@@ -0,0 +1,427 @@
+package goasterix
+
+import (
+	"errors"
+	"io"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// Reset empties the Record while keeping its allocated memory, so it can be reused by DecodeNoCopy.
+// The items previously decoded must no longer be used after Reset.
+func (rec *Record) Reset() {
+	rec.Cat = 0
+	rec.Fspec = nil
+	rec.Items = rec.Items[:0]
+}
+
+// DecodeNoCopy extracts a Record of asterix data block (only one record) like Decode,
+// but without copying: the FSPEC and the data of the items are slices of data, which must not be modified
+// while the Record is used. The items of a Record previously emptied by Reset are reused,
+// so decoding records of the same UAP does not allocate once the Record is warmed up.
+// It returns the number of bytes unread, a decoding error is returned as a *DecodeError.
+func (rec *Record) DecodeNoCopy(data []byte, stdUAP uap.StandardUAP) (unRead int, err error) {
+	rec.Cat = stdUAP.Category
+	cond := stdUAP.EffectiveCondition()
+
+	n := fspecLength(data)
+	if n == 0 || data[n-1]&0x01 != 0 {
+		return 0, &DecodeError{
+			Category:  rec.Cat,
+			Expected:  len(data) + 1,
+			Available: len(data),
+			Err:       truncated(data[n:]),
+		}
+	}
+	rec.Fspec = data[:n:n]
+	if n > maxFspecLength {
+		return len(data) - n, &DecodeError{Category: rec.Cat, Offset: maxFspecLength, Err: ErrFRNUnknown}
+	}
+
+	offset := uint8(0) // offset shifts the index for a conditional UAP
+	for j, val := range rec.Fspec {
+		for i := 0; i < 7; i++ {
+			if val&(0x80>>i) == 0 {
+				continue
+			}
+			frn := uint8(7*j + i + 1)
+			index, found := uapIndex(frn, offset, stdUAP.Items)
+			if !found {
+				return len(data) - n, &DecodeError{Category: rec.Cat, FRN: frn, Offset: n, Err: ErrFRNUnknown}
+			}
+			uapItem := stdUAP.Items[index] // here the index corresponds to the FRN
+			start := n
+
+			var item *Item
+			rec.Items, item = nextItem(rec.Items, uapItem)
+			size, err := sliceDataField(item, data[start:], uapItem, stdUAP.Items)
+			if err != nil {
+				rec.Items = rec.Items[:len(rec.Items)-1]
+				expected, _ := itemLength(data[start:], uapItem, stdUAP.Items)
+				return len(data) - start, &DecodeError{
+					Category:  rec.Cat,
+					FRN:       uapItem.FRN,
+					DataItem:  uapItem.DataItem,
+					Offset:    start,
+					Expected:  expected,
+					Available: len(data) - start,
+					Err:       err,
+				}
+			}
+			n += size
+
+			if cond != nil && frn == cond.FRN {
+				stdUAP.Items, err = selectUAPConditional(cond, *item)
+				if err != nil {
+					return len(data) - n, &DecodeError{
+						Category: rec.Cat,
+						FRN:      uapItem.FRN,
+						DataItem: uapItem.DataItem,
+						Offset:   start,
+						Err:      err,
+					}
+				}
+				offset = frn
+			}
+		}
+	}
+	return len(data) - n, nil
+}
+
+// Reset empties the DataBlock while keeping its allocated records, so it can be reused by DecodeNoCopy.
+// The records previously decoded must no longer be used after Reset.
+func (db *DataBlock) Reset() {
+	db.Category = 0
+	db.Len = 0
+	db.Records = db.Records[:0]
+}
+
+// DecodeNoCopy extracts an asterix data block: CAT + LEN + N * RECORD(S) like Decode,
+// but without copying: the records are decoded by Record.DecodeNoCopy and refer to data.
+// The records of a DataBlock previously emptied by Reset are reused.
+// A decoding error is returned as a *DecodeError, except io.EOF when data is empty.
+func (db *DataBlock) DecodeNoCopy(data []byte) (int, error) {
+	return db.decodeNoCopy(data, defaultProfile)
+}
+
+func (db *DataBlock) decodeNoCopy(data []byte, selector profileSelector) (int, error) {
+	if len(data) == 0 {
+		return 0, io.EOF
+	}
+	db.Category = data[0]
+	if len(data) < 3 {
+		return 0, &DecodeError{Category: db.Category, Record: -1, Expected: 3, Available: len(data), Err: truncated(data[1:])}
+	}
+	db.Len = uint16(data[1])<<8 | uint16(data[2])
+	if db.Len < 3 {
+		return len(data) - 3, &DecodeError{Category: db.Category, Record: -1, Offset: 1, Err: ErrLenUndersized}
+	}
+	if len(data) < int(db.Len) {
+		return len(data) - 3, &DecodeError{
+			Category:  db.Category,
+			Record:    -1,
+			Expected:  int(db.Len),
+			Available: len(data),
+			Err:       ErrUndersized,
+		}
+	}
+
+	records := data[3:db.Len]
+	unRead := len(data) - int(db.Len)
+	offset := 0
+	for {
+		uapSelected, found := selector(db.Category, records[offset:])
+		if !found {
+			return unRead, &DecodeError{Category: db.Category, Record: len(db.Records), Offset: 3 + offset, Err: ErrCategoryUnknown}
+		}
+
+		rec := db.nextRecord()
+		recUnRead, err := rec.DecodeNoCopy(records[offset:], uapSelected)
+		if err != nil {
+			var de *DecodeError
+			if errors.As(err, &de) {
+				de.Record = len(db.Records) - 1
+				de.Offset += 3 + offset
+			}
+			return recUnRead, err
+		}
+		offset = len(records) - recUnRead
+		if recUnRead == 0 {
+			break
+		}
+	}
+	return unRead, nil
+}
+
+// nextRecord appends a Record to db.Records, reusing the Record of the backing array left by Reset.
+func (db *DataBlock) nextRecord() *Record {
+	n := len(db.Records)
+	if n < cap(db.Records) && db.Records[:n+1][n] != nil {
+		db.Records = db.Records[:n+1]
+		db.Records[n].Reset()
+	} else {
+		db.Records = append(db.Records, NewRecord())
+	}
+	return db.Records[n]
+}
+
+// nextItem appends an item of field to items, reusing the item of the backing array left by a reset.
+func nextItem(items []Item, field uap.DataField) ([]Item, *Item) {
+	n := len(items)
+	if n < cap(items) {
+		items = items[:n+1]
+	} else {
+		items = append(items, Item{})
+	}
+	item := &items[n]
+	resetItem(item, field)
+	return items, item
+}
+
+// resetItem sets the meta of item from field and keeps only the pointer matching the type of field,
+// it is reused by sliceDataField.
+func resetItem(item *Item, field uap.DataField) {
+	item.Meta = MetaItem{
+		FRN:         field.FRN,
+		DataItem:    field.DataItem,
+		Description: field.Description,
+		Type:        field.Type,
+	}
+	if field.Type != uap.Fixed {
+		item.Fixed = nil
+	}
+	if field.Type != uap.Extended {
+		item.Extended = nil
+	}
+	if field.Type != uap.Explicit {
+		item.Explicit = nil
+	}
+	if field.Type != uap.Repetitive {
+		item.Repetitive = nil
+	}
+	if field.Type != uap.RepetitiveFX {
+		item.RepetitiveFX = nil
+	}
+	if field.Type != uap.Compound {
+		item.Compound = nil
+	}
+	if field.Type != uap.RFS {
+		item.RFS = nil
+	}
+	if field.Type != uap.SP && field.Type != uap.RE {
+		item.SP = nil
+	}
+}
+
+// sliceDataField fills item with the beginning of data according to its data field by slicing data (no copy)
+// and returns the number of bytes of the item.
+// The items of the UAP are used to read the fields of a RFS item.
+func sliceDataField(item *Item, data []byte, field uap.DataField, items []uap.DataField) (int, error) {
+	switch field.Type {
+	case uap.Fixed, uap.Extended, uap.Explicit, uap.SP, uap.RE, uap.Repetitive, uap.RepetitiveFX:
+		if field.Type == uap.Repetitive && field.Repetitive.Compound != nil {
+			return sliceRepetitiveCompound(item, data, field.Repetitive.Compound)
+		}
+		if zeroLen(data, field) {
+			return 0, ErrLenInvalid
+		}
+		n, ok := itemLength(data, field, nil)
+		if !ok {
+			return 0, truncated(data)
+		}
+		sliceFlat(item, data[:n:n], field)
+		if field.Type == uap.Explicit && field.Explicit.Compound == nil {
+			item.Explicit.Compound = nil
+		}
+		if field.Type == uap.Explicit && field.Explicit.Compound != nil {
+			if item.Explicit.Compound == nil {
+				item.Explicit.Compound = new(Compound)
+			}
+			size, err := sliceCompound(item.Explicit.Compound, item.Explicit.Data, field.Explicit.Compound)
+			if err != nil {
+				return 0, err
+			}
+			if size != len(item.Explicit.Data) {
+				return 0, ErrItemMalformed
+			}
+		}
+		return n, nil
+
+	case uap.Compound:
+		if item.Compound == nil {
+			item.Compound = new(Compound)
+		}
+		return sliceCompound(item.Compound, data, field.Compound)
+
+	case uap.RFS:
+		if item.RFS == nil {
+			item.RFS = new(RandomFieldSequencing)
+		}
+		rfs := item.RFS
+		rfs.Sequence = rfs.Sequence[:0]
+
+		if len(data) < 1 {
+			return 0, io.EOF
+		}
+		rfs.N = data[0]
+		n := 1
+		for i := uint8(0); i < rfs.N; i++ {
+			if len(data) < n+1 {
+				return 0, io.EOF
+			}
+			frn := data[n]
+			n++
+			rf, found := lookupRandomField(items, frn)
+			if !found {
+				return 0, ErrFRNUnknown
+			}
+
+			k := len(rfs.Sequence)
+			if k < cap(rfs.Sequence) {
+				rfs.Sequence = rfs.Sequence[:k+1]
+			} else {
+				rfs.Sequence = append(rfs.Sequence, RandomField{})
+			}
+			rfs.Sequence[k].FRN = frn
+			sub := &rfs.Sequence[k].Field
+			resetItem(sub, rf)
+			size, err := sliceDataField(sub, data[n:], rf, nil)
+			if err != nil {
+				return 0, err
+			}
+			n += size
+		}
+		return n, nil
+	}
+	return 0, ErrDataFieldUnknown
+}
+
+// sliceCompound fills cp with the compound of fields at the beginning of data by slicing data (no copy)
+// and returns the number of bytes of the compound.
+func sliceCompound(cp *Compound, data []byte, fields []uap.DataField) (int, error) {
+	cp.Secondary = cp.Secondary[:0]
+
+	n := fspecLength(data)
+	if n == 0 || data[n-1]&0x01 != 0 {
+		return 0, truncated(data[n:])
+	}
+	cp.Primary = data[:n:n]
+	if n > maxFspecLength {
+		return 0, ErrFRNUnknown
+	}
+	for j, val := range cp.Primary {
+		for i := 0; i < 7; i++ {
+			if val&(0x80>>i) == 0 {
+				continue
+			}
+			frn := 7*j + i + 1
+			if frn > len(fields) || fields[frn-1].Type == uap.Spare {
+				return 0, ErrFRNUnknown
+			}
+			var sub *Item
+			cp.Secondary, sub = nextItem(cp.Secondary, fields[frn-1])
+			size, err := sliceDataField(sub, data[n:], fields[frn-1], nil)
+			if err != nil {
+				return 0, err
+			}
+			n += size
+		}
+	}
+	return n, nil
+}
+
+// sliceRepetitiveCompound fills item with a Repetitive whose repetitions are compounds of fields
+// by slicing data (no copy) and returns the number of bytes of the item.
+func sliceRepetitiveCompound(item *Item, data []byte, fields []uap.DataField) (int, error) {
+	if item.Repetitive == nil {
+		item.Repetitive = new(Repetitive)
+	}
+	r := item.Repetitive
+	r.Compounds = r.Compounds[:0]
+
+	if len(data) < 1 {
+		return 0, io.EOF
+	}
+	r.Rep = data[0]
+	n := 1
+	for i := uint8(0); i < r.Rep; i++ {
+		k := len(r.Compounds)
+		if k < cap(r.Compounds) {
+			r.Compounds = r.Compounds[:k+1]
+		} else {
+			r.Compounds = append(r.Compounds, Compound{})
+		}
+		size, err := sliceCompound(&r.Compounds[k], data[n:], fields)
+		if err != nil {
+			return 0, err
+		}
+		n += size
+	}
+	r.Data = data[1:n:n]
+	return n, nil
+}
+
+// sliceFlat fills item (not Compound nor RFS) with data which holds exactly the bytes of the item.
+func sliceFlat(item *Item, data []byte, field uap.DataField) {
+	switch field.Type {
+	case uap.Fixed:
+		if item.Fixed == nil {
+			item.Fixed = new(Fixed)
+		}
+		item.Fixed.Data = data
+
+	case uap.Extended:
+		if item.Extended == nil {
+			item.Extended = new(Extended)
+		}
+		primary := int(field.Extended.PrimarySize)
+		item.Extended.Primary = data[:primary:primary]
+		item.Extended.Secondary = nil
+		if len(data) > primary {
+			item.Extended.Secondary = data[primary:]
+		}
+
+	case uap.Explicit:
+		if item.Explicit == nil {
+			item.Explicit = new(Explicit)
+		}
+		item.Explicit.Len = data[0]
+		item.Explicit.Data = data[1:]
+
+	case uap.SP, uap.RE:
+		if item.SP == nil {
+			item.SP = new(SpecialPurpose)
+		}
+		item.SP.Len = data[0]
+		item.SP.Data = data[1:]
+		item.SP.Items = nil
+
+	case uap.Repetitive:
+		if item.Repetitive == nil {
+			item.Repetitive = new(Repetitive)
+		}
+		item.Repetitive.Rep = data[0]
+		item.Repetitive.Data = data[1:]
+		item.Repetitive.Compounds = nil
+
+	case uap.RepetitiveFX:
+		if item.RepetitiveFX == nil {
+			item.RepetitiveFX = new(RepetitiveFX)
+		}
+		size := int(field.Repetitive.SubItemSize)
+		r := item.RepetitiveFX
+		r.Elements = r.Elements[:0]
+		for i := 0; i < len(data); i += size {
+			r.Elements = append(r.Elements, data[i:i+size:i+size])
+		}
+	}
+}
+
+// truncated returns the error of a read which needs more bytes than the rest of data,
+// like binary.Read: io.EOF when data is empty, io.ErrUnexpectedEOF otherwise.
+func truncated(data []byte) error {
+	if len(data) == 0 {
+		return io.EOF
+	}
+	return io.ErrUnexpectedEOF
+}
@@ -0,0 +1,291 @@
+package goasterix
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestRecordDecodeNoCopy(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		uap          uap.StandardUAP
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "cat255 fixed",
+			input:        "e008837dfd9c58",
+			uap:          uap.Cat255StrV51,
+		},
+		{
+			TestCaseName: "cat001 conditional UAP",
+			input:        "f50208319801bf0a1ebb43022538e200",
+			uap:          uap.Cat001V12,
+		},
+		{
+			TestCaseName: "cat048 compound",
+			input:        "fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+			uap:          uap.Cat048V127,
+		},
+		{
+			TestCaseName: "cat4test all types",
+			input:        "fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff",
+			uap:          uap.Cat4Test,
+		},
+		{
+			TestCaseName: "cat030 artas",
+			input:        "afbbf317f1300883040070a8bcf3ff07070723f0a8800713feb7022b0389038b140704012c080811580000001e7004f04aa004b0012400544e49413531313206c84c45424c48454c58",
+			uap:          uap.Cat030ArtasV70,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		expected := NewRecord()
+		expectedUnRead, _ := expected.Decode(data, row.uap)
+		rec := NewRecord()
+
+		// Act
+		unRead, err := rec.DecodeNoCopy(data, row.uap)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, nil)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, nil)
+		}
+		if unRead != expectedUnRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, expectedUnRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, expectedUnRead)
+		}
+		if reflect.DeepEqual(rec, expected) == false {
+			t.Errorf("FAIL: %s record = %v; Expected: %v", row.TestCaseName, rec.String(), expected.String())
+		} else {
+			t.Logf("SUCCESS: record = %v; Expected: %v", rec.String(), expected.String())
+		}
+	}
+}
+
+func TestRecordDecodeNoCopy_SharedData(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("e008837dfd9c58")
+	rec := NewRecord()
+	_, _ = rec.DecodeNoCopy(data, uap.Cat255StrV51)
+
+	// Act
+	data[1] = 0xff
+
+	// Assert
+	if rec.Items[0].Fixed.Data[0] != 0xff {
+		t.Errorf("FAIL: data = %x; Expected: %x", rec.Items[0].Fixed.Data, []byte{0xff, 0x83})
+	} else {
+		t.Logf("SUCCESS: data = %x; Expected: %x", rec.Items[0].Fixed.Data, []byte{0xff, 0x83})
+	}
+}
+
+func TestRecordDecodeNoCopy_Reset(t *testing.T) {
+	// Arrange
+	first, _ := util.HexStringToByte("fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff")
+	second, _ := util.HexStringToByte("fc 0000 00 01 00 8000 010200")
+	expected := NewRecord()
+	_, _ = expected.Decode(second, uap.Cat4Test)
+	rec := NewRecord()
+	_, _ = rec.DecodeNoCopy(first, uap.Cat4Test)
+
+	// Act
+	rec.Reset()
+	unRead, err := rec.DecodeNoCopy(second, uap.Cat4Test)
+
+	// Assert
+	if err != nil || unRead != 0 {
+		t.Errorf("FAIL: unRead = %v, error = %v; Expected: %v, %v", unRead, err, 0, nil)
+	} else {
+		t.Logf("SUCCESS: unRead = %v, error = %v; Expected: %v, %v", unRead, err, 0, nil)
+	}
+	if reflect.DeepEqual(rec, expected) == false {
+		t.Errorf("FAIL: record = %v; Expected: %v", rec.String(), expected.String())
+	} else {
+		t.Logf("SUCCESS: record = %v; Expected: %v", rec.String(), expected.String())
+	}
+}
+
+func TestRecordDecodeNoCopy_Error(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		uap          uap.StandardUAP
+		err          error
+		unRead       int
+		nbOfItems    int
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "empty",
+			input:        "",
+			uap:          uap.Cat048V127,
+			err:          io.EOF,
+			unRead:       0,
+			nbOfItems:    0,
+		},
+		{
+			TestCaseName: "FSPEC truncated",
+			input:        "ff",
+			uap:          uap.Cat048V127,
+			err:          io.EOF,
+			unRead:       0,
+			nbOfItems:    0,
+		},
+		{
+			TestCaseName: "fixed item truncated",
+			input:        "e0 0836 429b",
+			uap:          uap.Cat048V127,
+			err:          io.ErrUnexpectedEOF,
+			unRead:       2,
+			nbOfItems:    1,
+		},
+		{
+			TestCaseName: "extended item truncated",
+			input:        "e0 0836 429b52",
+			uap:          uap.Cat048V127,
+			err:          io.EOF,
+			unRead:       0,
+			nbOfItems:    2,
+		},
+		{
+			TestCaseName: "RFS FRN unknown",
+			input:        "04 0107ffff",
+			uap:          uap.Cat4Test,
+			err:          ErrFRNUnknown,
+			unRead:       4,
+			nbOfItems:    0,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		rec := NewRecord()
+
+		// Act
+		unRead, err := rec.DecodeNoCopy(data, row.uap)
+
+		// Assert
+		var de *DecodeError
+		if errors.Is(err, row.err) == false || errors.As(err, &de) == false {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, row.err)
+		}
+		if unRead != row.unRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, row.unRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, row.unRead)
+		}
+		if len(rec.Items) != row.nbOfItems {
+			t.Errorf("FAIL: %s nbOfItems = %v; Expected: %v", row.TestCaseName, len(rec.Items), row.nbOfItems)
+		} else {
+			t.Logf("SUCCESS: nbOfItems = %v; Expected: %v", len(rec.Items), row.nbOfItems)
+		}
+	}
+}
+
+func TestDataBlockDecodeNoCopy(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		err          error
+		unRead       int
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "two records",
+			input:        "300011 e0 0836 429b52 a0 e0 0836 429b52 a0",
+			err:          nil,
+			unRead:       0,
+		},
+		{
+			TestCaseName: "oversize data",
+			input:        "30000a e0 0836 429b52 a0 ffff",
+			err:          nil,
+			unRead:       2,
+		},
+		{
+			TestCaseName: "undersized data",
+			input:        "300012 e0 0836 429b52 a0",
+			err:          ErrUndersized,
+			unRead:       7,
+		},
+		{
+			TestCaseName: "LEN undersized",
+			input:        "300002 e0",
+			err:          ErrLenUndersized,
+			unRead:       1,
+		},
+		{
+			TestCaseName: "record truncated",
+			input:        "300008 e0 0836 429b",
+			err:          io.ErrUnexpectedEOF,
+			unRead:       2,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		expected := NewDataBlock()
+		_, _ = expected.Decode(data)
+		db := NewDataBlock()
+
+		// Act
+		unRead, err := db.DecodeNoCopy(data)
+
+		// Assert
+		if errors.Is(err, row.err) == false {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, row.err)
+		}
+		if unRead != row.unRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, row.unRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, row.unRead)
+		}
+		if row.err == nil && reflect.DeepEqual(db, expected) == false {
+			t.Errorf("FAIL: %s datablock = %v; Expected: %v", row.TestCaseName, db.String(), expected.String())
+		}
+	}
+}
+
+func TestDataBlockDecodeNoCopy_Reset(t *testing.T) {
+	// Arrange
+	first, _ := util.HexStringToByte("300011 e0 0836 429b52 a0 e0 0836 429b52 a0")
+	second, _ := util.HexStringToByte("30000a e0 0836 429b53 a0")
+	expected := NewDataBlock()
+	_, _ = expected.Decode(second)
+	db := NewDataBlock()
+	_, _ = db.DecodeNoCopy(first)
+
+	// Act
+	db.Reset()
+	_, err := db.DecodeNoCopy(second)
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: error = %v; Expected: %v", err, nil)
+	}
+	if reflect.DeepEqual(db, expected) == false {
+		t.Errorf("FAIL: datablock = %v; Expected: %v", db.String(), expected.String())
+	} else {
+		t.Logf("SUCCESS: datablock = %v; Expected: %v", db.String(), expected.String())
+	}
+}
@@ -0,0 +1,108 @@
+package goasterix
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+)
+
+// blockResult is the result of the decoding of one data block by a worker of decodeParallel.
+type blockResult struct {
+	db     *DataBlock
+	unRead int
+	err    error
+	done   bool
+}
+
+// DecodeParallel extracts one or more asterix data blocks using the uap.DefaultProfiles like Decode,
+// but the data blocks are decoded concurrently by a pool of workers (runtime.GOMAXPROCS when workers <= 0).
+// The boundaries of the data blocks are first scanned with their LEN fields, then the data blocks are decoded
+// and appended to DataBlocks in their original order.
+// As Decode, it stops at the first data block which cannot be decoded and returns its error.
+// When ctx is cancelled, the data blocks decoded before the first pending one are appended to DataBlocks,
+// and it returns the number of bytes unRead from this pending data block with ctx.Err().
+func (w *WrapperDataBlock) DecodeParallel(ctx context.Context, data []byte, workers int) (unRead int, err error) {
+	return w.decodeParallel(ctx, data, workers, defaultProfile)
+}
+
+func (w *WrapperDataBlock) decodeParallel(ctx context.Context, data []byte, workers int, selector profileSelector) (unRead int, err error) {
+	starts := scanDataBlocks(data)
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(starts) {
+		workers = len(starts)
+	}
+
+	results := make([]blockResult, len(starts))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				// a data block is bounded by the next one, its LEN field is checked against this bound
+				end := len(data)
+				if index+1 < len(starts) {
+					end = starts[index+1]
+				}
+				db := NewDataBlock()
+				unRead, err := db.decode(data[starts[index]:end], selector)
+				if errors.Is(err, ErrCategoryUnknown) {
+					unRead += len(data) - end // as Decode, the data blocks after this one are unread
+				}
+				results[index] = blockResult{db: db, unRead: unRead, err: err, done: true}
+			}
+		}()
+	}
+
+Dispatch:
+	for index := range starts {
+		select {
+		case jobs <- index:
+		case <-ctx.Done():
+			break Dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for index, res := range results {
+		if !res.done {
+			return len(data) - starts[index], ctx.Err()
+		}
+		if res.err != nil {
+			return res.unRead, shiftOffset(res.err, starts[index])
+		}
+		w.DataBlocks = append(w.DataBlocks, res.db)
+	}
+	return 0, nil
+}
+
+// scanDataBlocks returns the offsets of the data blocks of data using their LEN fields without decoding them.
+// When the header of a data block is truncated or inconsistent, its offset is the last one returned:
+// its decoding reports the error.
+func scanDataBlocks(data []byte) []int {
+	var starts []int
+	offset := 0
+	for {
+		starts = append(starts, offset)
+		remaining := data[offset:]
+		if len(remaining) < 3 {
+			return starts
+		}
+		length := int(remaining[1])<<8 + int(remaining[2])
+		if length < 3 || length > len(remaining) {
+			return starts
+		}
+		offset += length
+		if offset == len(data) {
+			return starts
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package goasterix
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestWrapperDataBlockDecodeParallel(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		workers      int
+	}
+	blockA := "300011 e0 0836 429b52 a0 e0 0836 429b52 a0"
+	blockB := "30000a e0 0836 429b53 a0"
+	blockC := "300118fff7020836429b52a094c70181091302d06002b7490d0138a178cf422002e79a5d27a00c0060a3280030a4000040063a0743ce5b4020f5fff7020836429b54e000bc020901a2005c7802e800263946e50464b1cb6ca0029ea9491062a4546093880032d4000040059602f639590220f5fff7020836429b58a0909703ff026405a26002bb4066740815f6e795e002e56a0530ffdff860b0d80032fc00004003cf0810c9ef4020fdfff7020836429b56a0775d03700ec205786002be4060910815f9c363a002a49a0f30bfffff60c4600030a4000040057207674a004020fdfff7020836429b55a0468c029804b105786002c57101124d6070d3282002adfa3333a0140060c4600030a4000040026e07d75fc04020f5"
+	valid := strings.Join([]string{blockA, blockB, blockC, blockA, blockB, blockC, blockA}, " ")
+	dataSet := []dataTest{
+		{
+			TestCaseName: "one worker",
+			input:        valid,
+			workers:      1,
+		},
+		{
+			TestCaseName: "several workers",
+			input:        valid,
+			workers:      3,
+		},
+		{
+			TestCaseName: "default workers",
+			input:        valid,
+			workers:      0,
+		},
+		{
+			TestCaseName: "empty",
+			input:        "",
+			workers:      2,
+		},
+		{
+			TestCaseName: "category unknown",
+			input:        blockA + " 05000a e0 0836 429b53 a0 " + blockB,
+			workers:      2,
+		},
+		{
+			TestCaseName: "record error",
+			input:        blockA + " " + blockB + " 300008 e0 0836 429b " + blockA,
+			workers:      2,
+		},
+		{
+			TestCaseName: "header truncated",
+			input:        blockA + " " + blockB + " 3000",
+			workers:      2,
+		},
+		{
+			TestCaseName: "block undersized",
+			input:        blockA + " 300020 e0 0836 429b53 a0",
+			workers:      2,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		expected, _ := NewWrapperDataBlock()
+		expectedUnRead, expectedErr := expected.Decode(data)
+		w, _ := NewWrapperDataBlock()
+
+		// Act
+		unRead, err := w.DecodeParallel(context.Background(), data, row.workers)
+
+		// Assert
+		if errors.Is(err, expectedErr) == false && reflect.DeepEqual(err, expectedErr) == false {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, expectedErr)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, expectedErr)
+		}
+		if unRead != expectedUnRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, expectedUnRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, expectedUnRead)
+		}
+		if reflect.DeepEqual(w.DataBlocks, expected.DataBlocks) == false {
+			t.Errorf("FAIL: %s nb of datablocks = %v; Expected: %v", row.TestCaseName, len(w.DataBlocks), len(expected.DataBlocks))
+		} else {
+			t.Logf("SUCCESS: nb of datablocks = %v; Expected: %v", len(w.DataBlocks), len(expected.DataBlocks))
+		}
+	}
+}
+
+func TestWrapperDataBlockDecodeParallel_Large(t *testing.T) {
+	// Arrange: 3500 data blocks of 24 bytes, i.e. 84000 bytes beyond the 64 KiB of a LEN field
+	block, _ := util.HexStringToByte("300018 e0 0836 429b52 a0 e0 0836 429b52 a0 e0 0836 429b53 a0")
+	var data []byte
+	for i := 0; i < 3500; i++ {
+		data = append(data, block...)
+	}
+	expected, _ := NewWrapperDataBlock()
+	expectedUnRead, expectedErr := expected.Decode(data)
+	w, _ := NewWrapperDataBlock()
+
+	// Act
+	unRead, err := w.DecodeParallel(context.Background(), data, 4)
+
+	// Assert
+	if err != nil || expectedErr != nil || unRead != 0 || expectedUnRead != 0 {
+		t.Errorf("FAIL: unRead = %v, error = %v, sequential error = %v; Expected: %v, %v, %v",
+			unRead, err, expectedErr, 0, nil, nil)
+	} else {
+		t.Logf("SUCCESS: unRead = %v, error = %v; Expected: %v, %v", unRead, err, 0, nil)
+	}
+	if len(w.DataBlocks) != 3500 || reflect.DeepEqual(w.DataBlocks, expected.DataBlocks) == false {
+		t.Errorf("FAIL: nb of datablocks = %v; Expected: %v", len(w.DataBlocks), 3500)
+	} else {
+		t.Logf("SUCCESS: nb of datablocks = %v; Expected: %v", len(w.DataBlocks), 3500)
+	}
+}
+
+func TestWrapperDataBlockDecodeParallel_Cancel(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("300011 e0 0836 429b52 a0 e0 0836 429b52 a0 30000a e0 0836 429b53 a0")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	w, _ := NewWrapperDataBlock()
+
+	// Act
+	unRead, err := w.DecodeParallel(ctx, data, 2)
+
+	// Assert
+	if errors.Is(err, context.Canceled) == false {
+		t.Errorf("FAIL: error = %v; Expected: %v", err, context.Canceled)
+	} else {
+		t.Logf("SUCCESS: error = %v; Expected: %v", err, context.Canceled)
+	}
+	if unRead != len(data) || len(w.DataBlocks) != 0 {
+		t.Errorf("FAIL: unRead = %v, nb of datablocks = %v; Expected: %v, %v", unRead, len(w.DataBlocks), len(data), 0)
+	} else {
+		t.Logf("SUCCESS: unRead = %v, nb of datablocks = %v; Expected: %v, %v", unRead, len(w.DataBlocks), len(data), 0)
+	}
+}
+
+func TestDecoder_DecodeWrapperParallel(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("300011 e0 0836 429b52 a0 e0 0836 429b52 a0 30000a e0 0836 429b53 a0")
+	d := NewDecoder()
+	expected, _, _ := d.DecodeWrapper(data)
+
+	// Act
+	w, unRead, err := d.DecodeWrapperParallel(context.Background(), data, 2)
+
+	// Assert
+	if err != nil || unRead != 0 {
+		t.Errorf("FAIL: unRead = %v, error = %v; Expected: %v, %v", unRead, err, 0, nil)
+	} else {
+		t.Logf("SUCCESS: unRead = %v, error = %v; Expected: %v, %v", unRead, err, 0, nil)
+	}
+	if reflect.DeepEqual(w.DataBlocks, expected.DataBlocks) == false {
+		t.Errorf("FAIL: datablocks = %v; Expected: %v", w.DataBlocks, expected.DataBlocks)
+	} else {
+		t.Logf("SUCCESS: datablocks = %v; Expected: %v", w.DataBlocks, expected.DataBlocks)
+	}
+}
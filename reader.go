@@ -0,0 +1,76 @@
+package goasterix
+
+import (
+	"io"
+)
+
+// Reader reads asterix data blocks one by one from an io.Reader (e.g. a recording file or a TCP stream).
+// Only one data block is kept in memory at a time: the Reader reads the CAT + LEN header,
+// then exactly LEN bytes, and decodes them.
+type Reader struct {
+	r        io.Reader
+	selector profileSelector
+	decoder  *Decoder // decodes the SP and RE fields, nil for the uap.DefaultProfiles
+	buf      []byte
+}
+
+// NewReader returns a Reader which decodes the data blocks with the uap.DefaultProfiles.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:        r,
+		selector: defaultProfile,
+	}
+}
+
+// NewReader returns a Reader which decodes the data blocks with the profiles of the Decoder.
+func (d *Decoder) NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:        r,
+		selector: d.selectProfile,
+		decoder:  d,
+	}
+}
+
+// Next reads and decodes the next DataBlock.
+// It returns io.EOF when the source is exhausted at a data block boundary and io.ErrUnexpectedEOF
+// when the source ends in the middle of a data block.
+// When the data block is read but its decoding fails, Next returns the partially decoded DataBlock with the error,
+// the Reader stays aligned on the next data block and Next can be called again.
+func (rd *Reader) Next() (*DataBlock, error) {
+	if cap(rd.buf) < 3 {
+		rd.buf = make([]byte, 3, 512)
+	}
+	header := rd.buf[:3]
+
+	// retrieve category and length fields
+	_, err := io.ReadFull(rd.r, header)
+	if err != nil {
+		return nil, err // err = io.EOF or io.ErrUnexpectedEOF
+	}
+	length := int(header[1])<<8 + int(header[2])
+	if length < 3 {
+		return nil, ErrLenUndersized
+	}
+
+	// retrieve records
+	if cap(rd.buf) < length {
+		tmp := make([]byte, length)
+		copy(tmp, header)
+		rd.buf = tmp
+	}
+	data := rd.buf[:length]
+	_, err = io.ReadFull(rd.r, data[3:])
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	db := NewDataBlock()
+	_, err = db.decode(data, rd.selector)
+	if rd.decoder != nil {
+		rd.decoder.decodeSpecialPurpose(db)
+	}
+	return db, err
+}
@@ -0,0 +1,120 @@
+package goasterix
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestReader_Next(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		nbOfRecords  []int // number of records of each data block read
+		err          error // error expected at the end
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT048 + CAT255 STR + CAT034",
+			input: "300118fff7020836429b52a094c70181091302d06002b7490d0138a178cf422002e79a5d27a00c0060a3280030a4000040063a0743ce5b4020f5fff7020836429b54e000bc020901a2005c7802e800263946e50464b1cb6ca0029ea9491062a4546093880032d4000040059602f639590220f5fff7020836429b58a0909703ff026405a26002bb4066740815f6e795e002e56a0530ffdff860b0d80032fc00004003cf0810c9ef4020fdfff7020836429b56a0775d03700ec205786002be4060910815f9c363a002a49a0f30bfffff60c4600030a4000040057207674a004020fdfff7020836429b55a0468c029804b105786002c57101124d6070d3282002adfa3333a0140060c4600030a4000040026e07d75fc04020f5" +
+				"ff000ae008837e019d58" +
+				"220014f6083602429b7110940028200094008000",
+			nbOfRecords: []int{5, 1, 1},
+			err:         io.EOF,
+		},
+		{
+			TestCaseName: "empty",
+			input:        "",
+			nbOfRecords:  nil,
+			err:          io.EOF,
+		},
+		{
+			TestCaseName: "truncated header",
+			input:        "ff000ae008837e019d58 ff00",
+			nbOfRecords:  []int{1},
+			err:          io.ErrUnexpectedEOF,
+		},
+		{
+			TestCaseName: "truncated records",
+			input:        "ff000ae008837e019d58 ff000ae00883",
+			nbOfRecords:  []int{1},
+			err:          io.ErrUnexpectedEOF,
+		},
+		{
+			TestCaseName: "undersized length field",
+			input:        "ff000ae008837e019d58 ff0002",
+			nbOfRecords:  []int{1},
+			err:          ErrLenUndersized,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		rd := NewReader(iotest.OneByteReader(bytes.NewReader(data)))
+
+		// Act
+		var nbOfRecords []int
+		var err error
+		for {
+			var db *DataBlock
+			db, err = rd.Next()
+			if err != nil {
+				break
+			}
+			nbOfRecords = append(nbOfRecords, len(db.Records))
+		}
+
+		// Assert
+		if err != row.err {
+			t.Errorf("FAIL: %s error: %s; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
+		}
+		if len(nbOfRecords) != len(row.nbOfRecords) {
+			t.Errorf("FAIL: %s nbOfDataBlocks = %v; Expected: %v", row.TestCaseName, len(nbOfRecords), len(row.nbOfRecords))
+			continue
+		}
+		for i := range nbOfRecords {
+			if nbOfRecords[i] != row.nbOfRecords[i] {
+				t.Errorf("FAIL: %s nbOfRecords = %v; Expected: %v", row.TestCaseName, nbOfRecords[i], row.nbOfRecords[i])
+			} else {
+				t.Logf("SUCCESS: nbOfRecords = %v; Expected: %v", nbOfRecords[i], row.nbOfRecords[i])
+			}
+		}
+	}
+}
+
+func TestReader_NextAfterDecodeError(t *testing.T) {
+	// Arrange
+	// the first data block is an unknown category, the reader stays aligned on the second one
+	data, _ := util.HexStringToByte("00 0005 ffff" + "ff000ae008837e019d58")
+	rd := NewReader(bytes.NewReader(data))
+
+	// Act
+	_, err1 := rd.Next()
+	db, err2 := rd.Next()
+	_, err3 := rd.Next()
+
+	// Assert
+	if !errors.Is(err1, ErrCategoryUnknown) {
+		t.Errorf("FAIL: error: %s; Expected: %v", err1, ErrCategoryUnknown)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err1, ErrCategoryUnknown)
+	}
+	if err2 != nil || db.Category != 255 {
+		t.Errorf("FAIL: error: %s, category: %v; Expected: %v, %v", err2, db.Category, nil, 255)
+	} else {
+		t.Logf("SUCCESS: error: %v, category: %v; Expected: %v, %v", err2, db.Category, nil, 255)
+	}
+	if err3 != io.EOF {
+		t.Errorf("FAIL: error: %s; Expected: %v", err3, io.EOF)
+	} else {
+		t.Logf("SUCCESS: error: %v; Expected: %v", err3, io.EOF)
+	}
+}
@@ -14,6 +14,12 @@ import (
 var (
 	// ErrDataFieldUnknown reports which ErrDatafield Unknown.
 	ErrDataFieldUnknown = errors.New("type of datafield not found")
+
+	// ErrFRNUnknown reports which a FRN of a RFS organised field is not defined by the UAP.
+	ErrFRNUnknown = errors.New("[ASTERIX] FRN unknown in UAP")
+
+	// ErrConditionUnknown reports which the discriminating item of a conditional UAP matches none of its variants.
+	ErrConditionUnknown = errors.New("[ASTERIX] conditional UAP variant not found")
 )
 
 type Record struct {
@@ -29,93 +35,73 @@ func NewRecord() *Record {
 // Decode extracts a Record of asterix data block (only one record).
 // An asterix data block can contain a or more records.
 // It returns the number of bytes unread and fills the Record Struct(Fspec, Items array) in byte.
+// A decoding error is returned as a *DecodeError giving the faulty item and its offset in data.
 func (rec *Record) Decode(data []byte, stdUAP uap.StandardUAP) (unRead int, err error) {
 	rec.Cat = stdUAP.Category
+	cond := stdUAP.EffectiveCondition()
 
 	rb := bytes.NewReader(data)
 	rec.Fspec, err = FspecReader(rb)
 	unRead = rb.Len()
 	if err != nil {
-		return unRead, err
+		return unRead, &DecodeError{
+			Category:  rec.Cat,
+			Expected:  len(data) + 1,
+			Available: len(data),
+			Err:       err,
+		}
+	}
+
+	if len(rec.Fspec) > maxFspecLength {
+		return unRead, &DecodeError{Category: rec.Cat, Offset: maxFspecLength, Err: ErrFRNUnknown}
 	}
 
 	frnIndex := FspecIndex(rec.Fspec)
 	offset := uint8(0) // offset shifts the index for a conditional UAP
 
 	for _, frn := range frnIndex {
-		uapItem := stdUAP.Items[frn-1-offset] // here the index corresponds to the FRN
-
-		item := NewItem(uapItem)
-		switch uapItem.Type {
-		case uap.Fixed:
-			tmp, err := FixedDataFieldReader(rb, uapItem.Fixed.Size)
-			if err != nil {
-				unRead = rb.Len()
-				return unRead, err
-			}
-			item.Fixed = &tmp
-
-		case uap.Extended:
-			tmp, err := ExtendedDataFieldReader(rb, uapItem.Extended.PrimarySize, uapItem.Extended.SecondarySize)
-			if err != nil {
-				unRead = rb.Len()
-				return unRead, err
-			}
-			item.Extended = &tmp
-
-		case uap.Explicit:
-			tmp, err := ExplicitDataFieldReader(rb)
-			if err != nil {
-				unRead = rb.Len()
-				return unRead, err
-			}
-			item.Explicit = &tmp
-
-		case uap.Repetitive:
-			tmp, err := RepetitiveDataFieldReader(rb, uapItem.Repetitive.SubItemSize)
-			if err != nil {
-				unRead = rb.Len()
-				return unRead, err
-			}
-			item.Repetitive = &tmp
-
-		case uap.Compound:
-			tmp, err := CompoundDataFieldReader(rb, uapItem.Compound)
-			if err != nil {
-				unRead = rb.Len()
-				return unRead, err
-			}
-			item.Compound = &tmp
-
-		case uap.SP, uap.RE:
-			tmp, err := SPAndREDataFieldReader(rb)
-			if err != nil {
-				unRead = rb.Len()
-				return unRead, err
-			}
-			item.SP = &tmp
+		start := len(data) - rb.Len()
+		index, found := uapIndex(frn, offset, stdUAP.Items)
+		if !found {
+			return unRead, &DecodeError{Category: rec.Cat, FRN: frn, Offset: start, Err: ErrFRNUnknown}
+		}
+		uapItem := stdUAP.Items[index] // here the index corresponds to the FRN
 
-		case uap.RFS:
-			tmp, err := RFSDataFieldReader(rb, stdUAP.Items)
-			if err != nil {
-				unRead = rb.Len()
-				return unRead, err
-			}
+		var item *Item
+		if uapItem.Type == uap.RFS {
+			item = NewItem(uapItem)
+			var tmp RandomFieldSequencing
+			tmp, err = RFSDataFieldReader(rb, stdUAP.Items)
 			item.RFS = &tmp
-
-		default:
-			err = ErrDataFieldUnknown
-			return unRead, err
+		} else {
+			item, err = DataFieldReader(rb, uapItem)
+		}
+		if err != nil {
+			unRead = rb.Len()
+			expected, _ := itemLength(data[start:], uapItem, stdUAP.Items)
+			return unRead, &DecodeError{
+				Category:  rec.Cat,
+				FRN:       uapItem.FRN,
+				DataItem:  uapItem.DataItem,
+				Offset:    start,
+				Expected:  expected,
+				Available: len(data) - start,
+				Err:       err,
+			}
 		}
 		unRead = rb.Len()
 		rec.Items = append(rec.Items, *item)
 
-		if uapItem.Conditional {
-			switch item.Meta.Type {
-			case uap.Fixed:
-				stdUAP.Items = selectUAPConditional(stdUAP.Category, item.Fixed.Data)
-			case uap.Extended:
-				stdUAP.Items = selectUAPConditional(stdUAP.Category, item.Extended.Primary)
+		if cond != nil && frn == cond.FRN {
+			stdUAP.Items, err = selectUAPConditional(cond, *item)
+			if err != nil {
+				return unRead, &DecodeError{
+					Category: rec.Cat,
+					FRN:      uapItem.FRN,
+					DataItem: uapItem.DataItem,
+					Offset:   start,
+					Err:      err,
+				}
 			}
 			offset = frn
 		}
@@ -146,25 +132,81 @@ func (rec Record) Payload() []byte {
 	return pd
 }
 
-func selectUAPConditional(category uint8, field []byte) []uap.DataField {
-	var selectedUAP []uap.DataField
-	switch category {
-	case 1:
-		tmp := field[0] & 0x80 >> 7
-		if tmp == 1 {
-			selectedUAP = uap.Cat001TrackV12
-		} else {
-			selectedUAP = uap.Cat001PlotV12
+// Item returns the item of the record identified by its DataItem (e.g. "I048/040") and true,
+// or nil and false when the record does not contain it.
+func (rec *Record) Item(dataItem string) (*Item, bool) {
+	for i := range rec.Items {
+		if rec.Items[i].Meta.DataItem == dataItem {
+			return &rec.Items[i], true
 		}
-	case 26:
-		tmp := field[0] & 0x80 >> 7
-		if tmp == 1 {
-			selectedUAP = uap.Cat4TestTrack
-		} else {
-			selectedUAP = uap.Cat4TestPlot
+	}
+	return nil, false
+}
+
+// ItemByFRN returns the item of the record identified by its FRN and true,
+// or nil and false when the record does not contain it.
+func (rec *Record) ItemByFRN(frn uint8) (*Item, bool) {
+	for i := range rec.Items {
+		if rec.Items[i].Meta.FRN == frn {
+			return &rec.Items[i], true
 		}
 	}
-	return selectedUAP
+	return nil, false
+}
+
+// Has returns true if the FSPEC of the record indicates the presence of the item identified by its FRN.
+func (rec *Record) Has(frn uint8) bool {
+	if frn == 0 {
+		return false
+	}
+	j := int(frn-1) / 7
+	i := (frn - 1) % 7
+	return j < len(rec.Fspec) && rec.Fspec[j]&(0x80>>i) != 0
+}
+
+// SubItem returns the sub-item identified by subItem (e.g. "ADR") of the compound item identified by dataItem
+// (e.g. "I062/380") and true, or nil and false when the record does not contain it.
+func (rec *Record) SubItem(dataItem string, subItem string) (*Item, bool) {
+	item, found := rec.Item(dataItem)
+	if !found {
+		return nil, false
+	}
+	return item.SubItem(subItem)
+}
+
+// selectUAPConditional returns the items of the conditional UAP selected by the first octet of the discriminating item.
+func selectUAPConditional(cond *uap.Condition, item Item) ([]uap.DataField, error) {
+	field := firstOctet(item)
+	if len(field) == 0 {
+		return nil, ErrConditionUnknown
+	}
+	items, found := cond.Select(field[0])
+	if !found {
+		return nil, ErrConditionUnknown
+	}
+	return items, nil
+}
+
+// firstOctet returns the first octet of an item Fixed or Extended, it's used to select a conditional UAP.
+func firstOctet(item Item) []byte {
+	switch item.Meta.Type {
+	case uap.Fixed:
+		return item.Fixed.Data
+	case uap.Extended:
+		return item.Extended.Primary
+	}
+	return nil
+}
+
+// maxFspecLength is the maximum number of octets of a FSPEC or of the primary subfield of a compound:
+// beyond 36 octets, the FRNs (7 * 36 = 252) would not fit in an uint8.
+const maxFspecLength = 36
+
+// uapIndex returns the index of the data field of frn in the items of a UAP, offset shifts the index
+// for a conditional UAP. It returns false when the FRN is not defined by the items.
+func uapIndex(frn uint8, offset uint8, items []uap.DataField) (int, bool) {
+	index := int(frn) - 1 - int(offset)
+	return index, index >= 0 && index < len(items)
 }
 
 // FspecReader returns a slice of FSPEC data record asterix.
@@ -188,6 +230,7 @@ func FspecReader(reader io.Reader) ([]byte, error) {
 // FspecIndex returns an array of uint8 corresponding to number FRN(Field Reference Number of Items).
 // In other words, it transposes a fspec bits to an array FRNs.
 // e.g. fspec = 1010 1010 => frnIndex = []uint8{1, 3, 5, 7}
+// The FRNs of a fspec longer than 36 octets do not fit in an uint8, the decoders reject such a fspec.
 func FspecIndex(fspec []byte) []uint8 {
 	var frnIndex []uint8
 	for j, val := range fspec {
@@ -202,6 +245,109 @@ func FspecIndex(fspec []byte) []uint8 {
 	return frnIndex
 }
 
+// FspecFromIndex returns the FSPEC corresponding to an array of FRNs (Field Reference Number of Items).
+// In other words, it is the reverse of FspecIndex: the FX bit is set on each octet except the last one.
+// e.g. frnIndex = []uint8{1, 3, 5, 7, 8} => fspec = 1010 1011 1000 0000
+func FspecFromIndex(frnIndex []uint8) []byte {
+	var max uint8
+	for _, frn := range frnIndex {
+		if frn > max {
+			max = frn
+		}
+	}
+	if max == 0 {
+		return []byte{0x00}
+	}
+
+	fspec := make([]byte, (int(max)-1)/7+1)
+	for _, frn := range frnIndex {
+		if frn == 0 {
+			continue
+		}
+		j := (frn - 1) / 7
+		i := (frn - 1) % 7
+		fspec[j] |= 0x80 >> i
+	}
+	for j := 0; j < len(fspec)-1; j++ {
+		fspec[j] |= 0x01
+	}
+	return fspec
+}
+
+// DataFieldReader returns an Item read according to the type of the data field of the UAP:
+// Fixed, Extended, Explicit, Repetitive, Compound, SP or RE.
+// The contents of Explicit and the repetitions of Repetitive are read as compounds when the data field defines them.
+// A RFS data field depends on the other items of the UAP, it is read by RFSDataFieldReader.
+func DataFieldReader(rb *bytes.Reader, field uap.DataField) (*Item, error) {
+	item := NewItem(field)
+	switch field.Type {
+	case uap.Fixed:
+		tmp, err := FixedDataFieldReader(rb, field.Fixed.Size)
+		if err != nil {
+			return nil, err
+		}
+		item.Fixed = &tmp
+
+	case uap.Extended:
+		tmp, err := ExtendedDataFieldReader(rb, field.Extended.PrimarySize, field.Extended.SecondarySize)
+		if err != nil {
+			return nil, err
+		}
+		item.Extended = &tmp
+
+	case uap.Explicit:
+		tmp, err := ExplicitDataFieldReader(rb)
+		if err != nil {
+			return nil, err
+		}
+		if field.Explicit.Compound != nil {
+			err = explicitCompound(&tmp, field.Explicit.Compound)
+			if err != nil {
+				return nil, err
+			}
+		}
+		item.Explicit = &tmp
+
+	case uap.Repetitive:
+		var tmp Repetitive
+		var err error
+		if field.Repetitive.Compound != nil {
+			tmp, err = RepetitiveCompoundDataFieldReader(rb, field.Repetitive.Compound)
+		} else {
+			tmp, err = RepetitiveDataFieldReader(rb, field.Repetitive.SubItemSize)
+		}
+		if err != nil {
+			return nil, err
+		}
+		item.Repetitive = &tmp
+
+	case uap.RepetitiveFX:
+		tmp, err := RepetitiveFXDataFieldReader(rb, field.Repetitive.SubItemSize)
+		if err != nil {
+			return nil, err
+		}
+		item.RepetitiveFX = &tmp
+
+	case uap.Compound:
+		tmp, err := CompoundDataFieldReader(rb, field.Compound)
+		if err != nil {
+			return nil, err
+		}
+		item.Compound = &tmp
+
+	case uap.SP, uap.RE:
+		tmp, err := SPAndREDataFieldReader(rb)
+		if err != nil {
+			return nil, err
+		}
+		item.SP = &tmp
+
+	default:
+		return nil, ErrDataFieldUnknown
+	}
+	return item, nil
+}
+
 // FixedDataFieldReader extracts a number(nb) of bytes(size) and returns a slice of bytes(data of item).
 // Fixed length Data Fields shall comprise a fixed number of octets.
 func FixedDataFieldReader(rb *bytes.Reader, size uint8) (Fixed, error) {
@@ -263,6 +409,9 @@ func ExplicitDataFieldReader(rb *bytes.Reader) (Explicit, error) {
 	if err != nil {
 		return item, err
 	}
+	if item.Len == 0 {
+		return item, ErrLenInvalid // LEN includes its own octet
+	}
 
 	tmp := make([]byte, item.Len-1)
 	err = binary.Read(rb, binary.BigEndian, &tmp)
@@ -286,7 +435,7 @@ func RepetitiveDataFieldReader(rb *bytes.Reader, SubItemSize uint8) (Repetitive,
 		return item, err
 	}
 
-	tmp := make([]byte, item.Rep*SubItemSize)
+	tmp := make([]byte, int(item.Rep)*int(SubItemSize))
 	err = binary.Read(rb, binary.BigEndian, &tmp)
 	if err != nil {
 		return item, err
@@ -300,7 +449,9 @@ func RepetitiveDataFieldReader(rb *bytes.Reader, SubItemSize uint8) (Repetitive,
 // The primary subfield determines the presence or absence of the subsequent data subfields. It comprises a first part
 // of one octet extendable using the Field Extension (FX) mechanism.
 // The definition, structure and format of the data subfields are part of the description of the relevant Compound Data
-// Item. Data subfields shall be either fixed length, extended length, explicit length or repetitive, but not compound.
+// Item. Data subfields are read like the items of a record: fixed length, extended length, explicit length,
+// repetitive or compound. A bit of the primary subfield set for a Spare or an undefined data subfield
+// returns ErrFRNUnknown.
 func CompoundDataFieldReader(rb *bytes.Reader, cp []uap.DataField) (Compound, error) {
 	var err error
 	items := Compound{}
@@ -309,50 +460,86 @@ func CompoundDataFieldReader(rb *bytes.Reader, cp []uap.DataField) (Compound, er
 	if err != nil {
 		return items, err
 	}
+	if len(items.Primary) > maxFspecLength {
+		return items, ErrFRNUnknown
+	}
 	frnIndex := FspecIndex(items.Primary)
 
 	for _, frn := range frnIndex {
-		uapItem := cp[frn-1]
-		item := NewItem(uapItem)
-		switch uapItem.Type {
-		case uap.Fixed:
-			tmp, err := FixedDataFieldReader(rb, uapItem.Fixed.Size)
-			if err != nil {
-				return items, err
-			}
-			item.Fixed = &tmp
-			items.Secondary = append(items.Secondary, *item)
+		if int(frn) > len(cp) || cp[frn-1].Type == uap.Spare {
+			return items, ErrFRNUnknown
+		}
+		item, err := DataFieldReader(rb, cp[frn-1])
+		if err != nil {
+			return items, err
+		}
+		items.Secondary = append(items.Secondary, *item)
+	}
+	return items, err
+}
 
-		case uap.Extended:
-			tmp, err := ExtendedDataFieldReader(rb, uapItem.Extended.PrimarySize, uapItem.Extended.SecondarySize)
-			if err != nil {
-				return items, err
-			}
-			item.Extended = &tmp
-			items.Secondary = append(items.Secondary, *item)
+// RepetitiveFXDataFieldReader extracts data item type Repetitive whose repetitions are chained by the FX bit:
+// each repetition of subItemSize bytes is followed by another one while the last bit of its last octet (FX) is set.
+func RepetitiveFXDataFieldReader(rb *bytes.Reader, subItemSize uint8) (RepetitiveFX, error) {
+	var err error
+	item := RepetitiveFX{}
+	if subItemSize == 0 {
+		return item, ErrItemMalformed
+	}
 
-		case uap.Explicit:
-			tmp, err := ExplicitDataFieldReader(rb)
-			if err != nil {
-				return items, err
-			}
-			item.Explicit = &tmp
-			items.Secondary = append(items.Secondary, *item)
+	for {
+		tmp := make([]byte, subItemSize)
+		err = binary.Read(rb, binary.BigEndian, &tmp)
+		if err != nil {
+			return item, err
+		}
+		item.Elements = append(item.Elements, tmp)
+		if tmp[subItemSize-1]&0x01 == 0 {
+			break
+		}
+	}
+	return item, err
+}
 
-		case uap.Repetitive:
-			tmp, err := RepetitiveDataFieldReader(rb, uapItem.Repetitive.SubItemSize)
-			if err != nil {
-				return items, err
-			}
-			item.Repetitive = &tmp
-			items.Secondary = append(items.Secondary, *item)
+// RepetitiveCompoundDataFieldReader extracts data item type Repetitive whose repetitions are compounds of cp:
+// the first byte is REP(factor), followed by REP compounds read by CompoundDataFieldReader.
+// Data contains the bytes of the REP compounds and Compounds their decoded view.
+func RepetitiveCompoundDataFieldReader(rb *bytes.Reader, cp []uap.DataField) (Repetitive, error) {
+	var err error
+	item := Repetitive{}
 
-		default:
-			err = ErrDataFieldUnknown
-			return items, err
+	err = binary.Read(rb, binary.BigEndian, &item.Rep)
+	if err != nil {
+		return item, err
+	}
+
+	for i := uint8(0); i < item.Rep; i++ {
+		tmp, err := CompoundDataFieldReader(rb, cp)
+		if err != nil {
+			return item, err
 		}
+		item.Compounds = append(item.Compounds, tmp)
+		item.Data = append(item.Data, tmp.Payload()...)
 	}
-	return items, err
+	if item.Data == nil {
+		item.Data = []byte{}
+	}
+	return item, err
+}
+
+// explicitCompound decodes the contents of an explicit item e as a compound of cp,
+// the compound must fill the contents exactly.
+func explicitCompound(e *Explicit, cp []uap.DataField) error {
+	rb := bytes.NewReader(e.Data)
+	tmp, err := CompoundDataFieldReader(rb, cp)
+	if err != nil {
+		return err
+	}
+	if rb.Len() != 0 {
+		return ErrItemMalformed
+	}
+	e.Compound = &tmp
+	return nil
 }
 
 // RFSDataFieldReader
@@ -378,31 +565,31 @@ func RFSDataFieldReader(rb *bytes.Reader, items []uap.DataField) (RandomFieldSeq
 			return rfs, err
 		}
 
-		for _, field := range items {
-			if frn == field.FRN {
-				rf := new(RandomField)
-				rf.FRN = frn
-
-				// todo: work just for Fixed datafield use case
-				tmp := make([]byte, field.Fixed.Size)
-
-				err := binary.Read(rb, binary.BigEndian, &tmp)
-				if err != nil {
-					return rfs, err
-				}
-				item := NewItem(field)
-				fixed := Fixed{}
-				fixed.Data = tmp
-				item.Fixed = &fixed
-				rf.Field = *item
-				rfs.Sequence = append(rfs.Sequence, *rf)
-			}
+		field, found := lookupRandomField(items, frn)
+		if !found {
+			return rfs, ErrFRNUnknown
 		}
+		item, err := DataFieldReader(rb, field)
+		if err != nil {
+			return rfs, err
+		}
+		rfs.Sequence = append(rfs.Sequence, RandomField{FRN: frn, Field: *item})
 	}
 
 	return rfs, err
 }
 
+// lookupRandomField returns the data field of items identified by frn which can be part of a RFS organised field:
+// neither Spare nor RFS.
+func lookupRandomField(items []uap.DataField, frn uint8) (uap.DataField, bool) {
+	for _, field := range items {
+		if field.FRN == frn && field.Type != uap.Spare && field.Type != uap.RFS {
+			return field, true
+		}
+	}
+	return uap.DataField{}, false
+}
+
 // SPAndREDataFieldReader extracts returns a slice
 // ref. EUROCONTROL-SPEC-0149 2.4
 // 4.3.5 Non-Standard Data Fields:
@@ -416,6 +603,9 @@ func SPAndREDataFieldReader(rb *bytes.Reader) (SpecialPurpose, error) {
 	if err != nil {
 		return sp, err
 	}
+	if sp.Len == 0 {
+		return sp, ErrLenInvalid // LEN includes its own octet
+	}
 
 	tmp := make([]byte, sp.Len-1)
 	err = binary.Read(rb, binary.BigEndian, &tmp)
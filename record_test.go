@@ -2,9 +2,11 @@ package goasterix
 
 import (
 	"bytes"
+	"errors"
 	"github.com/mokhtarimokhtar/goasterix/util"
 	"io"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/mokhtarimokhtar/goasterix/uap"
@@ -130,6 +132,37 @@ func TestFspecIndex(t *testing.T) {
 
 }
 
+func TestFspecFromIndex(t *testing.T) {
+	type fspecTest struct {
+		input  []uint8
+		output []byte
+	}
+	// Arrange
+	dataSet := []fspecTest{
+		{input: []uint8{1}, output: []byte{0x80}},
+		{input: []uint8{7}, output: []byte{0x02}},
+		{input: []uint8{8}, output: []byte{0x01, 0x80}},
+		{input: []uint8{1, 2, 3, 4, 5, 6, 7}, output: []byte{0xfe}},
+		{input: []uint8{1, 3, 5, 7}, output: []byte{0xaa}},
+		{input: []uint8{}, output: []byte{0x00}},
+		{input: []uint8{1, 2, 3, 5, 6, 7, 8, 11, 12}, output: []byte{0xef, 0x98}},
+		{input: []uint8{12, 1, 3}, output: []byte{0xa1, 0x08}},
+		{input: []uint8{22}, output: []byte{0x01, 0x01, 0x01, 0x80}},
+	}
+
+	for _, row := range dataSet {
+		// Act
+		fspec := FspecFromIndex(row.input)
+
+		// Assert
+		if bytes.Equal(fspec, row.output) == false {
+			t.Errorf("FAIL: % X; Expected: % X", fspec, row.output)
+		} else {
+			t.Logf("SUCCESS: % X; Expected: % X", fspec, row.output)
+		}
+	}
+}
+
 // FixedDataField
 func TestFixedDataFieldReader_Valid(t *testing.T) {
 	// Arrange
@@ -387,6 +420,16 @@ func TestRepetitiveDataFieldReader(t *testing.T) {
 			},
 			err: io.EOF,
 		},
+		{
+			TestCaseName: "testcase 4: REP * size greater than 255",
+			input:        "21" + strings.Repeat("ff", 264),
+			SubItemSize:  8,
+			output: Repetitive{
+				Rep:  0x21,
+				Data: bytes.Repeat([]byte{0xff}, 264),
+			},
+			err: nil,
+		},
 	}
 	for _, row := range dataSet {
 		// Arrange
@@ -410,6 +453,135 @@ func TestRepetitiveDataFieldReader(t *testing.T) {
 	}
 }
 
+func TestRepetitiveFXDataFieldReader(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		SubItemSize  uint8
+		output       RepetitiveFX
+		unRead       int
+		err          error
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "testcase 1: two repetitions",
+			input:        "010203 040506",
+			SubItemSize:  3,
+			output: RepetitiveFX{
+				Elements: [][]byte{{0x01, 0x02, 0x03}, {0x04, 0x05, 0x06}},
+			},
+			unRead: 0,
+			err:    nil,
+		},
+		{
+			TestCaseName: "testcase 2: one repetition",
+			input:        "0102 ffff",
+			SubItemSize:  2,
+			output: RepetitiveFX{
+				Elements: [][]byte{{0x01, 0x02}},
+			},
+			unRead: 2,
+			err:    nil,
+		},
+		{
+			TestCaseName: "testcase 3: FX set on last repetition",
+			input:        "0103 ff",
+			SubItemSize:  2,
+			output: RepetitiveFX{
+				Elements: [][]byte{{0x01, 0x03}},
+			},
+			unRead: 0,
+			err:    io.ErrUnexpectedEOF,
+		},
+		{
+			TestCaseName: "testcase 4: empty",
+			input:        "",
+			SubItemSize:  2,
+			output:       RepetitiveFX{},
+			unRead:       0,
+			err:          io.EOF,
+		},
+		{
+			TestCaseName: "testcase 5: size zero",
+			input:        "01",
+			SubItemSize:  0,
+			output:       RepetitiveFX{},
+			unRead:       1,
+			err:          ErrItemMalformed,
+		},
+	}
+	for _, row := range dataSet {
+		// Arrange
+		input, _ := util.HexStringToByte(row.input)
+		rb := bytes.NewReader(input)
+
+		// Act
+		item, err := RepetitiveFXDataFieldReader(rb, row.SubItemSize)
+
+		// Assert
+		if err != row.err {
+			t.Errorf("FAIL: %s - error: %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
+		}
+		if rb.Len() != row.unRead {
+			t.Errorf("FAIL: %s - unRead = %v; Expected: %v", row.TestCaseName, rb.Len(), row.unRead)
+		}
+		if reflect.DeepEqual(item, row.output) == false {
+			t.Errorf("FAIL: %s - item = % X; Expected: % X", row.TestCaseName, item, row.output)
+		} else {
+			t.Logf("SUCCESS: item = % X; Expected: % X", item, row.output)
+		}
+	}
+}
+
+func TestRecordDecode_RepetitiveFX(t *testing.T) {
+	// Arrange
+	input := "81010108 0836 010203 040506"
+	data, _ := util.HexStringToByte(input)
+	rec := NewRecord()
+	output := "I062/510: 010203 040506"
+
+	// Act
+	unRead, err := rec.Decode(data, uap.Cat062V119)
+
+	// Assert
+	if err != nil || unRead != 0 {
+		t.Fatalf("FAIL: unRead = %v, error = %v; Expected: %v, %v", unRead, err, 0, nil)
+	}
+	item, found := rec.Item("I062/510")
+	if found == false || item.String() != output {
+		t.Errorf("FAIL: item = %v; Expected: %v", item, output)
+	} else {
+		t.Logf("SUCCESS: item = %v; Expected: %v", item.String(), output)
+	}
+	if bytes.Equal(rec.Payload(), data) == false {
+		t.Errorf("FAIL: payload = %x; Expected: %x", rec.Payload(), data)
+	}
+
+	noCopy := NewRecord()
+	_, err = noCopy.DecodeNoCopy(data, uap.Cat062V119)
+	if err != nil || reflect.DeepEqual(noCopy, rec) == false {
+		t.Errorf("FAIL: DecodeNoCopy = %v, error = %v; Expected: %v", noCopy.String(), err, rec.String())
+	}
+
+	if violations := rec.Validate(uap.Cat062V119); violations != nil {
+		t.Errorf("FAIL: violations = %v; Expected: %v", violations, nil)
+	}
+
+	encoded := NewRecord()
+	err = encoded.Encode(uap.Cat062V119, []Item{
+		rec.Items[0],
+		{Meta: MetaItem{DataItem: "I062/510"}, RepetitiveFX: &RepetitiveFX{Elements: [][]byte{{0x01, 0x02, 0x02}, {0x04, 0x05, 0x07}}}},
+	})
+	if err != nil || bytes.Equal(encoded.Payload(), data) == false {
+		t.Errorf("FAIL: encoded = %x, error = %v; Expected: %x", encoded.Payload(), err, data)
+	} else {
+		t.Logf("SUCCESS: encoded = %x; Expected: %x", encoded.Payload(), data)
+	}
+}
+
 // CompoundDataField
 func TestCompoundDataFieldReader(t *testing.T) {
 	// Setup
@@ -519,7 +691,7 @@ func TestCompoundDataFieldReader(t *testing.T) {
 			err:          io.EOF,
 		},
 		{
-			TestCaseName: "Compound type: ErrDataFieldUnknown",
+			TestCaseName: "Compound type: spare ErrFRNUnknown",
 			input:        "40 ff",
 			output: Compound{
 				Primary: []byte{0x40},
@@ -528,6 +700,30 @@ func TestCompoundDataFieldReader(t *testing.T) {
 				{FRN: 1, Type: uap.Fixed, Fixed: uap.FixedField{Size: 3}},
 				{FRN: 2, Type: uap.Spare, Fixed: uap.FixedField{Size: 2}},
 			},
+			err: ErrFRNUnknown,
+		},
+		{
+			TestCaseName: "Compound type: undefined ErrFRNUnknown",
+			input:        "20 ff",
+			output: Compound{
+				Primary: []byte{0x20},
+			},
+			item: []uap.DataField{
+				{FRN: 1, Type: uap.Fixed, Fixed: uap.FixedField{Size: 3}},
+				{FRN: 2, Type: uap.Spare},
+			},
+			err: ErrFRNUnknown,
+		},
+		{
+			TestCaseName: "Compound type: ErrDataFieldUnknown",
+			input:        "40 ff",
+			output: Compound{
+				Primary: []byte{0x40},
+			},
+			item: []uap.DataField{
+				{FRN: 1, Type: uap.Fixed, Fixed: uap.FixedField{Size: 3}},
+				{FRN: 2, Type: uap.RFS},
+			},
 			err: ErrDataFieldUnknown,
 		},
 		{
@@ -733,6 +929,64 @@ func TestRFSDataFieldReader(t *testing.T) {
 			output:       RandomFieldSequencing{},
 			err:          io.EOF,
 		},
+		{
+			TestCaseName: "testcase 5: extended field",
+			input:        "02 03 a1 40 0b 0001",
+			item:         uap.Cat048V127.Items,
+			output: RandomFieldSequencing{
+				N: 0x02,
+				Sequence: []RandomField{
+					{
+						FRN: 0x03,
+						Field: Item{
+							Meta: MetaItem{
+								FRN:         3,
+								DataItem:    "I048/020",
+								Description: "Target Report Descriptor",
+								Type:        uap.Extended,
+							},
+							Extended: &Extended{Primary: []byte{0xa1}, Secondary: []byte{0x40}},
+						},
+					},
+					{
+						FRN: 0x0b,
+						Field: Item{
+							Meta: MetaItem{
+								FRN:         11,
+								DataItem:    "I048/161",
+								Description: "Track Number",
+								Type:        uap.Fixed,
+							},
+							Fixed: &Fixed{Data: []byte{0x00, 0x01}},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			TestCaseName: "testcase 6: FRN unknown",
+			input:        "02 03 ffffffff 1f ff",
+			item:         uap.Cat001PlotV12,
+			output: RandomFieldSequencing{
+				N: 0x02,
+				Sequence: []RandomField{
+					{
+						FRN: 0x03,
+						Field: Item{
+							Meta: MetaItem{
+								FRN:         3,
+								DataItem:    "I001/040",
+								Description: "Measured Position in Polar Coordinates",
+								Type:        uap.Fixed,
+							},
+							Fixed: &Fixed{Data: []byte{0xff, 0xff, 0xff, 0xff}},
+						},
+					},
+				},
+			},
+			err: ErrFRNUnknown,
+		},
 	}
 
 	for _, row := range dataSet {
@@ -827,7 +1081,7 @@ func TestRecordDecode_NbOfItems(t *testing.T) {
 		unRead, err := rec.Decode(data, row.uap)
 
 		// Assert
-		if err != row.err {
+		if !errors.Is(err, row.err) {
 			t.Errorf("FAIL: error: %s; Expected: %v", err, row.err)
 		} else {
 			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
@@ -857,7 +1111,7 @@ func TestRecordDecode_Empty(t *testing.T) {
 	unRead, err := rec.Decode(data, uap048)
 
 	// Assert
-	if err != io.EOF {
+	if !errors.Is(err, io.EOF) {
 		t.Errorf("FAIL: error = %v; Expected: %v", err, io.EOF)
 	} else {
 		t.Logf("SUCCESS: error: %v; Expected: %v", err, io.EOF)
@@ -1235,7 +1489,7 @@ func TestRecordDecode_Cat4TestError(t *testing.T) {
 		remaining, err := rec.Decode(data, uap4Test)
 
 		// Assert
-		if err != row.err {
+		if !errors.Is(err, row.err) {
 			t.Errorf("FAIL: %s - error = %v; Expected: %v", row.TestCase, err, row.err)
 		} else {
 			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
@@ -1993,6 +2247,49 @@ func TestRecordDecode_CAT004(t *testing.T) {
 	}
 }
 
+func TestRecordDecode_CAT001PlotRFS(t *testing.T) {
+	// Arrange
+	// plot with I001/010, I001/020 and a RFS of I001/040, I001/070 and I001/130 (extended)
+	input := "c10102 0831 00 03 03 0a8abb2e 04 3802 06 8100"
+	output := []struct {
+		frn      uint8
+		dataItem string
+		data     []byte
+	}{
+		{frn: 3, dataItem: "I001/040", data: []byte{0x0a, 0x8a, 0xbb, 0x2e}},
+		{frn: 4, dataItem: "I001/070", data: []byte{0x38, 0x02}},
+		{frn: 6, dataItem: "I001/130", data: []byte{0x81, 0x00}},
+	}
+	data, _ := util.HexStringToByte(input)
+	rec := new(Record)
+
+	// Act
+	unRead, err := rec.Decode(data, uap.Cat001V12)
+
+	// Assert
+	if err != nil || unRead != 0 {
+		t.Fatalf("FAIL: error = %v, unRead = %v; Expected: %v, %v", err, unRead, nil, 0)
+	}
+	last := rec.Items[len(rec.Items)-1]
+	if last.Meta.Type != uap.RFS || last.RFS == nil || len(last.RFS.Sequence) != len(output) {
+		t.Fatalf("FAIL: item = %s %v; Expected: %v with %d fields", last.Meta.DataItem, last.Meta.Type, uap.RFS, len(output))
+	}
+	for i, rf := range last.RFS.Sequence {
+		if rf.FRN != output[i].frn || rf.Field.Meta.DataItem != output[i].dataItem || !bytes.Equal(rf.Field.Payload(), output[i].data) {
+			t.Errorf("FAIL: FRN %d %s = % X; Expected: FRN %d %s = % X",
+				rf.FRN, rf.Field.Meta.DataItem, rf.Field.Payload(), output[i].frn, output[i].dataItem, output[i].data)
+		} else {
+			t.Logf("SUCCESS: FRN %d %s = % X; Expected: FRN %d %s = % X",
+				rf.FRN, rf.Field.Meta.DataItem, rf.Field.Payload(), output[i].frn, output[i].dataItem, output[i].data)
+		}
+	}
+	if !bytes.Equal(rec.Payload(), data) {
+		t.Errorf("FAIL: payload = % X; Expected: % X", rec.Payload(), data)
+	} else {
+		t.Logf("SUCCESS: payload = % X; Expected: % X", rec.Payload(), data)
+	}
+}
+
 /*
 todo
 func TestRecordDecode_CAT001Track(t *testing.T) {
@@ -2343,3 +2640,467 @@ func TestRecordDecode_CAT030ARTAS(t *testing.T) {
 	}
 }
 */
+
+func TestRecordDecode_ConditionalUAP(t *testing.T) {
+	// Setup
+	type dataTest struct {
+		TestCase string
+		input    string
+		output   []Item
+		unRead   int
+		err      error
+	}
+	variantA := []uap.DataField{
+		{FRN: 2, DataItem: "I000/002", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1}},
+	}
+	variantB := []uap.DataField{
+		{FRN: 2, DataItem: "I000/002", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2}},
+	}
+	uapConditional := uap.StandardUAP{
+		Category: 0,
+		Items: []uap.DataField{
+			{FRN: 1, DataItem: "I000/001", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1}},
+		},
+		Condition: &uap.Condition{
+			FRN:  1,
+			Mask: 0xc0,
+			Variants: []uap.Variant{
+				{Value: 0x00, Items: variantA},
+				{Value: 0x40, Items: variantB},
+			},
+		},
+	}
+	dataSet := []dataTest{
+		{
+			TestCase: "variant A",
+			input:    "c0 3f ff",
+			output: []Item{
+				{Meta: MetaItem{FRN: 1, DataItem: "I000/001", Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0x3f}}},
+				{Meta: MetaItem{FRN: 2, DataItem: "I000/002", Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0xff}}},
+			},
+			unRead: 0,
+			err:    nil,
+		},
+		{
+			TestCase: "variant B",
+			input:    "c0 7f ffff",
+			output: []Item{
+				{Meta: MetaItem{FRN: 1, DataItem: "I000/001", Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0x7f}}},
+				{Meta: MetaItem{FRN: 2, DataItem: "I000/002", Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0xff, 0xff}}},
+			},
+			unRead: 0,
+			err:    nil,
+		},
+		{
+			TestCase: "variant unknown",
+			input:    "c0 80 ffff",
+			output: []Item{
+				{Meta: MetaItem{FRN: 1, DataItem: "I000/001", Type: uap.Fixed}, Fixed: &Fixed{Data: []byte{0x80}}},
+			},
+			unRead: 2,
+			err:    ErrConditionUnknown,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		rec := new(Record)
+
+		// Act
+		unRead, err := rec.Decode(data, uapConditional)
+
+		// Assert
+		if !errors.Is(err, row.err) {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCase, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
+		}
+		if unRead != row.unRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCase, unRead, row.unRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, row.unRead)
+		}
+		if reflect.DeepEqual(rec.Items, row.output) == false {
+			t.Errorf("FAIL: %s %v; \nExpected: %v", row.TestCase, rec.Items, row.output)
+		} else {
+			t.Logf("SUCCESS: %v; Expected: %v", rec.Items, row.output)
+		}
+	}
+}
+
+func TestRecord_Lookup(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		frn          uint8
+		has          bool
+		output       string
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "fixed item",
+			frn:          1,
+			has:          true,
+			output:       "I026/001: ffff",
+		},
+		{
+			TestCaseName: "item of second FSPEC octet",
+			frn:          9,
+			has:          true,
+			output:       "SP: 03ffff",
+		},
+		{
+			TestCaseName: "spare FRN",
+			frn:          7,
+			has:          false,
+		},
+		{
+			TestCaseName: "FRN beyond FSPEC",
+			frn:          15,
+			has:          false,
+		},
+		{
+			TestCaseName: "FRN zero",
+			frn:          0,
+			has:          false,
+		},
+	}
+	data, _ := util.HexStringToByte("fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff")
+	rec := NewRecord()
+	_, _ = rec.Decode(data, uap.Cat4Test)
+
+	for _, row := range dataSet {
+		// Arrange
+		// Act
+		has := rec.Has(row.frn)
+		item, found := rec.ItemByFRN(row.frn)
+
+		// Assert
+		if has != row.has || found != row.has {
+			t.Errorf("FAIL: %s has = %v, found = %v; Expected: %v", row.TestCaseName, has, found, row.has)
+		} else {
+			t.Logf("SUCCESS: has = %v, found = %v; Expected: %v", has, found, row.has)
+		}
+		if found && item.String() != row.output {
+			t.Errorf("FAIL: %s item = %v; Expected: %v", row.TestCaseName, item.String(), row.output)
+		}
+	}
+}
+
+func TestRecord_SubItem(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		dataItem     string
+		subItem      string
+		found        bool
+		output       string
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "compound sub-item",
+			dataItem:     "I026/005",
+			subItem:      "Compound/003",
+			found:        true,
+			output:       "Compound/003: fffe",
+		},
+		{
+			TestCaseName: "sub-item not present",
+			dataItem:     "I026/005",
+			subItem:      "Compound/002",
+			found:        false,
+		},
+		{
+			TestCaseName: "item not compound",
+			dataItem:     "I026/001",
+			subItem:      "Compound/001",
+			found:        false,
+		},
+		{
+			TestCaseName: "item not present",
+			dataItem:     "I026/010",
+			subItem:      "Compound/001",
+			found:        false,
+		},
+	}
+	data, _ := util.HexStringToByte("fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff")
+	rec := NewRecord()
+	_, _ = rec.Decode(data, uap.Cat4Test)
+
+	for _, row := range dataSet {
+		// Arrange
+		// Act
+		item, found := rec.SubItem(row.dataItem, row.subItem)
+
+		// Assert
+		if found != row.found {
+			t.Errorf("FAIL: %s found = %v; Expected: %v", row.TestCaseName, found, row.found)
+		} else {
+			t.Logf("SUCCESS: found = %v; Expected: %v", found, row.found)
+		}
+		if found && item.String() != row.output {
+			t.Errorf("FAIL: %s item = %v; Expected: %v", row.TestCaseName, item.String(), row.output)
+		}
+	}
+}
+
+var uapNestedCompound = uap.StandardUAP{
+	Name:     "nested compound",
+	Category: 26,
+	Items: []uap.DataField{
+		{FRN: 1, DataItem: "I026/001", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2}},
+		{
+			FRN: 2, DataItem: "I026/002", Type: uap.Compound,
+			Compound: []uap.DataField{
+				{FRN: 1, DataItem: "A", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1}},
+				{FRN: 2, Type: uap.Spare},
+				{FRN: 3, Type: uap.Spare},
+				{FRN: 4, Type: uap.Spare},
+				{FRN: 5, Type: uap.Spare},
+				{FRN: 6, Type: uap.Spare},
+				{FRN: 7, Type: uap.Spare},
+				{FRN: 8, DataItem: "B", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2}},
+				{
+					FRN: 9, DataItem: "C", Type: uap.Compound,
+					Compound: []uap.DataField{
+						{FRN: 1, DataItem: "C1", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1}},
+						{FRN: 2, DataItem: "C2", Type: uap.Extended, Extended: uap.ExtendedField{PrimarySize: 1, SecondarySize: 1}},
+					},
+				},
+			},
+		},
+		{
+			FRN: 3, DataItem: "I026/003", Type: uap.Repetitive,
+			Repetitive: uap.RepetitiveField{
+				Compound: []uap.DataField{
+					{FRN: 1, DataItem: "R1", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1}},
+					{FRN: 2, Type: uap.Spare},
+					{FRN: 3, DataItem: "R3", Type: uap.Explicit},
+				},
+			},
+		},
+		{
+			FRN: 4, DataItem: "I026/004", Type: uap.Explicit,
+			Explicit: uap.ExplicitField{
+				Compound: []uap.DataField{
+					{FRN: 1, DataItem: "E1", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2}},
+					{FRN: 2, DataItem: "E2", Type: uap.Repetitive, Repetitive: uap.RepetitiveField{SubItemSize: 1}},
+				},
+			},
+		},
+	},
+}
+
+func TestRecordDecode_NestedCompound(t *testing.T) {
+	// Arrange
+	input := "f0 0836 81c0aabbbbc0112322 02a00103ffff8002 07c0123402aabb"
+	data, _ := util.HexStringToByte(input)
+	rec := NewRecord()
+
+	// Act
+	unRead, err := rec.Decode(data, uapNestedCompound)
+
+	// Assert
+	if err != nil || unRead != 0 {
+		t.Fatalf("FAIL: unRead = %v, error = %v; Expected: %v, %v", unRead, err, 0, nil)
+	}
+	if bytes.Equal(rec.Payload(), data) == false {
+		t.Errorf("FAIL: payload = %x; Expected: %x", rec.Payload(), data)
+	} else {
+		t.Logf("SUCCESS: payload = %x; Expected: %x", rec.Payload(), data)
+	}
+	c2, found := rec.Items[1].Compound.Secondary[2].SubItem("C2")
+	if found == false || bytes.Equal(c2.Extended.Secondary, []byte{0x22}) == false {
+		t.Errorf("FAIL: nested compound C2 found = %v; Expected: %v", found, "2322")
+	} else {
+		t.Logf("SUCCESS: nested compound C2 = %v", c2.String())
+	}
+	compounds := rec.Items[2].Repetitive.Compounds
+	if len(compounds) != 2 || bytes.Equal(compounds[0].Secondary[1].Explicit.Data, []byte{0xff, 0xff}) == false {
+		t.Errorf("FAIL: repetitive compounds = %v; Expected: %v", compounds, "[a00103ffff 8002]")
+	} else {
+		t.Logf("SUCCESS: repetitive compounds = %v", compounds)
+	}
+	cp := rec.Items[3].Explicit.Compound
+	if cp == nil || bytes.Equal(cp.Secondary[1].Repetitive.Data, []byte{0xaa, 0xbb}) == false {
+		t.Errorf("FAIL: explicit compound = %v; Expected: %v", cp, "c0123402aabb")
+	} else {
+		t.Logf("SUCCESS: explicit compound = %v", cp.String())
+	}
+
+	noCopy := NewRecord()
+	_, err = noCopy.DecodeNoCopy(data, uapNestedCompound)
+	if err != nil || reflect.DeepEqual(noCopy, rec) == false {
+		t.Errorf("FAIL: DecodeNoCopy = %v, error = %v; Expected: %v", noCopy.String(), err, rec.String())
+	}
+
+	selected := NewRecord()
+	unRead, err = selected.DecodeItems(data, uapNestedCompound, "I026/004")
+	if err != nil || unRead != 0 || reflect.DeepEqual(selected.Items, rec.Items[3:]) == false {
+		t.Errorf("FAIL: DecodeItems = %v, unRead = %v, error = %v; Expected: %v", selected.Items, unRead, err, rec.Items[3:])
+	}
+
+	if violations := rec.Validate(uapNestedCompound); violations != nil {
+		t.Errorf("FAIL: violations = %v; Expected: %v", violations, nil)
+	}
+
+	encoded := NewRecord()
+	err = encoded.Encode(uapNestedCompound, rec.Items)
+	if err != nil || bytes.Equal(encoded.Payload(), data) == false {
+		t.Errorf("FAIL: encoded = %x, error = %v; Expected: %x", encoded.Payload(), err, data)
+	} else {
+		t.Logf("SUCCESS: encoded = %x; Expected: %x", encoded.Payload(), data)
+	}
+}
+
+func TestRecordDecode_NestedCompoundError(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCase string
+		input    string
+		err      error
+	}
+	dataSet := []dataTest{
+		{
+			TestCase: "spare sub-item",
+			input:    "c0 0836 40aa",
+			err:      ErrFRNUnknown,
+		},
+		{
+			TestCase: "sub-item beyond compound",
+			input:    "c0 0836 0120aa",
+			err:      ErrFRNUnknown,
+		},
+		{
+			TestCase: "spare sub-item of repetitive compound",
+			input:    "a0 0836 0140ff",
+			err:      ErrFRNUnknown,
+		},
+		{
+			TestCase: "explicit contents longer than compound",
+			input:    "90 0836 08c0123402aabbff",
+			err:      ErrItemMalformed,
+		},
+		{
+			TestCase: "repetitive compound truncated",
+			input:    "a0 0836 02a00103ffff",
+			err:      io.EOF,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		rec := NewRecord()
+		noCopy := NewRecord()
+
+		// Act
+		_, err := rec.Decode(data, uapNestedCompound)
+		_, errNoCopy := noCopy.DecodeNoCopy(data, uapNestedCompound)
+
+		// Assert
+		if errors.Is(err, row.err) == false || errors.Is(errNoCopy, row.err) == false {
+			t.Errorf("FAIL: %s error = %v, DecodeNoCopy error = %v; Expected: %v", row.TestCase, err, errNoCopy, row.err)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, row.err)
+		}
+	}
+}
+
+func TestRecordDecode_DeprecatedConditional(t *testing.T) {
+	// Setup
+	type dataTest struct {
+		TestCase string
+		input    string
+	}
+	// a profile of CAT001 declaring its discriminating item with the deprecated DataField.Conditional
+	legacy := uap.StandardUAP{Name: uap.Cat001V12.Name, Category: 1, Version: uap.Cat001V12.Version}
+	legacy.Items = append(legacy.Items, uap.Cat001V12.Items...)
+	legacy.Items[1].Conditional = true
+	dataSet := []dataTest{
+		{TestCase: "plot", input: "e0 0836 20 00010002"},
+		{TestCase: "track", input: "e0 0836 a0 0102"},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		expected := new(Record)
+		_, errExpected := expected.Decode(data, uap.Cat001V12)
+		rec := new(Record)
+
+		// Act
+		unRead, err := rec.Decode(data, legacy)
+
+		// Assert
+		if err != nil || errExpected != nil || unRead != 0 {
+			t.Errorf("FAIL: %s error = %v, %v, unRead = %v; Expected: %v, %v", row.TestCase, err, errExpected, unRead, nil, 0)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, nil)
+		}
+		if reflect.DeepEqual(rec.Items, expected.Items) == false {
+			t.Errorf("FAIL: %s %v; \nExpected: %v", row.TestCase, rec.Items, expected.Items)
+		} else {
+			t.Logf("SUCCESS: %v; Expected: %v", rec.Items, expected.Items)
+		}
+	}
+}
+
+func TestRecordDecode_CompoundProfiles(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCase string
+		input    string
+		uap      uap.StandardUAP
+		dataItem string
+		subItem  string
+		rep      uint8
+	}
+	dataSet := []dataTest{
+		{
+			TestCase: "CAT021 I021/110 trajectory intent",
+			input: "8101010104 0836 c0 8100 02" +
+				" 01 0064 0a0000 020000 00 000100 0000" +
+				" 02 00c8 0b0000 030000 00 000200 0000",
+			uap:      uap.Cat021v10,
+			dataItem: "I021/110",
+			subItem:  "TID",
+			rep:      2,
+		},
+		{
+			TestCase: "CAT062 I062/390 flight plan related data",
+			input:    "810102 0836 8148 0836 32374c 02 20000001 40000002",
+			uap:      uap.Cat062V119,
+			dataItem: "I062/390",
+			subItem:  "TOD",
+			rep:      2,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		rec := NewRecord()
+
+		// Act
+		unRead, err := rec.Decode(data, row.uap)
+
+		// Assert
+		if err != nil || unRead != 0 {
+			t.Errorf("FAIL: %s - unRead = %v, error = %v; Expected: %v, %v", row.TestCase, unRead, err, 0, nil)
+			continue
+		}
+		if bytes.Equal(rec.Payload(), data) == false {
+			t.Errorf("FAIL: %s - payload = %x; Expected: %x", row.TestCase, rec.Payload(), data)
+		} else {
+			t.Logf("SUCCESS: payload = %x; Expected: %x", rec.Payload(), data)
+		}
+		sub, found := rec.SubItem(row.dataItem, row.subItem)
+		if found == false || sub.Repetitive == nil || sub.Repetitive.Rep != row.rep {
+			t.Errorf("FAIL: %s - %s found = %v; Expected: %v repetitions", row.TestCase, row.subItem, found, row.rep)
+		} else {
+			t.Logf("SUCCESS: %s = %v", row.subItem, sub.String())
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package goasterix
+
+import (
+	"bytes"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// DecodeItems extracts a Record of asterix data block (only one record) like Decode,
+// but only the items whose DataItem is in dataItems (e.g. "I048/040", "I048/250") are decoded in Items,
+// the other items are skipped by computing their length without allocating them.
+// Fspec is the FSPEC of data, so Has reports all the items present in data, decoded or not. The Payload of the
+// Record is therefore partial: the FSPEC of data followed by the decoded items only, it can not be decoded again.
+// It returns the number of bytes unread, a decoding error is returned as a *DecodeError.
+func (rec *Record) DecodeItems(data []byte, stdUAP uap.StandardUAP, dataItems ...string) (unRead int, err error) {
+	rec.Cat = stdUAP.Category
+	cond := stdUAP.EffectiveCondition()
+
+	n := fspecLength(data)
+	if n == 0 || data[n-1]&0x01 != 0 {
+		return 0, &DecodeError{
+			Category:  rec.Cat,
+			Expected:  len(data) + 1,
+			Available: len(data),
+			Err:       truncated(data[n:]),
+		}
+	}
+	if n > maxFspecLength {
+		return len(data) - n, &DecodeError{Category: rec.Cat, Offset: maxFspecLength, Err: ErrFRNUnknown}
+	}
+	rec.Fspec = append([]byte(nil), data[:n]...)
+	offset := uint8(0) // offset shifts the index for a conditional UAP
+	for _, frn := range FspecIndex(data[:n]) {
+		index, found := uapIndex(frn, offset, stdUAP.Items)
+		if !found {
+			return len(data) - n, &DecodeError{Category: rec.Cat, FRN: frn, Offset: n, Err: ErrFRNUnknown}
+		}
+		uapItem := stdUAP.Items[index] // here the index corresponds to the FRN
+		start := n
+		if uapItem.Type == uap.Spare {
+			return len(data) - start, &DecodeError{
+				Category:  rec.Cat,
+				FRN:       uapItem.FRN,
+				DataItem:  uapItem.DataItem,
+				Offset:    start,
+				Available: len(data) - start,
+				Err:       ErrDataFieldUnknown,
+			}
+		}
+
+		size, ok := itemLength(data[start:], uapItem, stdUAP.Items)
+		if !ok {
+			err = truncated(data[start:])
+			if (uapItem.Type == uap.RFS || uapItem.Type == uap.Compound) && len(data)-start >= size {
+				err = ErrFRNUnknown // a FRN of the item is not defined, the data is not truncated
+			}
+			if zeroLen(data[start:], uapItem) {
+				err = ErrLenInvalid
+			}
+			return len(data) - start, &DecodeError{
+				Category:  rec.Cat,
+				FRN:       uapItem.FRN,
+				DataItem:  uapItem.DataItem,
+				Offset:    start,
+				Expected:  size,
+				Available: len(data) - start,
+				Err:       err,
+			}
+		}
+		n += size
+
+		if wanted(uapItem.DataItem, dataItems) {
+			rb := bytes.NewReader(data[start:n])
+			var item *Item
+			if uapItem.Type == uap.RFS {
+				item = NewItem(uapItem)
+				var tmp RandomFieldSequencing
+				tmp, err = RFSDataFieldReader(rb, stdUAP.Items)
+				item.RFS = &tmp
+			} else {
+				item, err = DataFieldReader(rb, uapItem)
+			}
+			if err != nil {
+				return len(data) - start, &DecodeError{
+					Category:  rec.Cat,
+					FRN:       uapItem.FRN,
+					DataItem:  uapItem.DataItem,
+					Offset:    start,
+					Available: len(data) - start,
+					Err:       err,
+				}
+			}
+			rec.Items = append(rec.Items, *item)
+		}
+
+		if cond != nil && frn == cond.FRN {
+			// the variant is selected by the first octet of the discriminating item, without decoding it
+			var found bool
+			stdUAP.Items, found = cond.Select(data[start])
+			if !found {
+				return len(data) - n, &DecodeError{
+					Category: rec.Cat,
+					FRN:      uapItem.FRN,
+					DataItem: uapItem.DataItem,
+					Offset:   start,
+					Err:      ErrConditionUnknown,
+				}
+			}
+			offset = frn
+		}
+	}
+	return len(data) - n, nil
+}
+
+// wanted returns true if dataItem is one of dataItems.
+func wanted(dataItem string, dataItems []string) bool {
+	for _, d := range dataItems {
+		if d == dataItem {
+			return true
+		}
+	}
+	return false
+}
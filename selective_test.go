@@ -0,0 +1,187 @@
+package goasterix
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestRecordDecodeItems(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		uap          uap.StandardUAP
+		dataItems    []string
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "cat048 some items",
+			input:        "fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+			uap:          uap.Cat048V127,
+			dataItems:    []string{"I048/010", "I048/140", "I048/040", "I048/250"},
+		},
+		{
+			TestCaseName: "cat048 no item",
+			input:        "fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5",
+			uap:          uap.Cat048V127,
+			dataItems:    nil,
+		},
+		{
+			TestCaseName: "cat001 conditional UAP",
+			input:        "f50208319801bf0a1ebb43022538e200",
+			uap:          uap.Cat001V12,
+			dataItems:    []string{"I001/010", "I001/040", "I001/141"},
+		},
+		{
+			TestCaseName: "cat4test compound and RFS",
+			input:        "fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff",
+			uap:          uap.Cat4Test,
+			dataItems:    []string{"I026/005", "I026/006", "SP"},
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		full := NewRecord()
+		expectedUnRead, _ := full.Decode(data, row.uap)
+		var expected []Item
+		for _, item := range full.Items {
+			if wanted(item.Meta.DataItem, row.dataItems) {
+				expected = append(expected, item)
+			}
+		}
+		expectedFspec := full.Fspec
+		rec := NewRecord()
+
+		// Act
+		unRead, err := rec.DecodeItems(data, row.uap, row.dataItems...)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, nil)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, nil)
+		}
+		if unRead != expectedUnRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, expectedUnRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, expectedUnRead)
+		}
+		if reflect.DeepEqual(rec.Fspec, expectedFspec) == false {
+			t.Errorf("FAIL: %s fspec = %x; Expected: %x", row.TestCaseName, rec.Fspec, expectedFspec)
+		} else {
+			t.Logf("SUCCESS: fspec = %x; Expected: %x", rec.Fspec, expectedFspec)
+		}
+		if len(expected) == 0 && len(rec.Items) == 0 {
+			continue
+		}
+		if reflect.DeepEqual(rec.Items, expected) == false {
+			t.Errorf("FAIL: %s items = %v; Expected: %v", row.TestCaseName, rec.Items, expected)
+		} else {
+			t.Logf("SUCCESS: items = %v; Expected: %v", rec.Items, expected)
+		}
+	}
+}
+
+func TestRecordDecodeItems_Error(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		uap          uap.StandardUAP
+		err          error
+		unRead       int
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "empty",
+			input:        "",
+			uap:          uap.Cat048V127,
+			err:          io.EOF,
+			unRead:       0,
+		},
+		{
+			TestCaseName: "skipped item truncated",
+			input:        "e0 0836 429b",
+			uap:          uap.Cat048V127,
+			err:          io.ErrUnexpectedEOF,
+			unRead:       2,
+		},
+		{
+			TestCaseName: "RFS FRN unknown",
+			input:        "04 0107ffff",
+			uap:          uap.Cat4Test,
+			err:          ErrFRNUnknown,
+			unRead:       4,
+		},
+		{
+			TestCaseName: "spare FRN",
+			input:        "02 ffff",
+			uap:          uap.Cat4Test,
+			err:          ErrDataFieldUnknown,
+			unRead:       2,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		rec := NewRecord()
+
+		// Act
+		unRead, err := rec.DecodeItems(data, row.uap, "I048/010")
+
+		// Assert
+		var de *DecodeError
+		if errors.Is(err, row.err) == false || errors.As(err, &de) == false {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error = %v; Expected: %v", err, row.err)
+		}
+		if unRead != row.unRead {
+			t.Errorf("FAIL: %s unRead = %v; Expected: %v", row.TestCaseName, unRead, row.unRead)
+		} else {
+			t.Logf("SUCCESS: unRead = %v; Expected: %v", unRead, row.unRead)
+		}
+	}
+}
+
+func TestRecordDecodeItems_Has(t *testing.T) {
+	// Arrange
+	data, _ := util.HexStringToByte("fff702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 0743ce5b 40 20f5")
+	output, _ := util.HexStringToByte("fff702 0836 94c70181")
+	rec := NewRecord()
+
+	// Act
+	_, err := rec.DecodeItems(data, uap.Cat048V127, "I048/010", "I048/040")
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: err = %v; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: err = %v; Expected: %v", err, nil)
+	}
+	_, decoded := rec.Item("I048/140")
+	if !rec.Has(2) || decoded {
+		t.Errorf("FAIL: I048/140 has = %v, decoded = %v; Expected: %v, %v", rec.Has(2), decoded, true, false)
+	} else {
+		t.Logf("SUCCESS: I048/140 has = %v, decoded = %v; Expected: %v, %v", rec.Has(2), decoded, true, false)
+	}
+	if rec.Has(12) {
+		t.Errorf("FAIL: I048/042 has = %v; Expected: %v", rec.Has(12), false)
+	} else {
+		t.Logf("SUCCESS: I048/042 has = %v; Expected: %v", rec.Has(12), false)
+	}
+	// the payload is partial: the FSPEC of data followed by the decoded items
+	if reflect.DeepEqual(rec.Payload(), output) == false {
+		t.Errorf("FAIL: payload = %x; Expected: %x", rec.Payload(), output)
+	} else {
+		t.Logf("SUCCESS: payload = %x; Expected: %x", rec.Payload(), output)
+	}
+}
@@ -0,0 +1,93 @@
+package goasterix
+
+import (
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// SPDecoder decodes the data of a Special Purpose or Reserved Expansion field (without its LEN octet) into items.
+type SPDecoder func(data []byte) ([]Item, error)
+
+// spKey identifies the SP or RE field of a category.
+type spKey struct {
+	category  uint8
+	fieldType uap.TypeField
+}
+
+// SetSPDecoder registers dec to decode the contents of the SP or RE field (fieldType uap.SP or uap.RE)
+// of the category and replaces the previous one.
+// The data blocks decoded by the Decoder then have the items of the field in SpecialPurpose.Items,
+// its Len and Data are kept.
+// It returns ErrDataFieldUnknown when fieldType is neither uap.SP nor uap.RE.
+func (d *Decoder) SetSPDecoder(category uint8, fieldType uap.TypeField, dec SPDecoder) error {
+	if fieldType != uap.SP && fieldType != uap.RE {
+		return ErrDataFieldUnknown
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.spDecoders[spKey{category: category, fieldType: fieldType}] = dec
+	return nil
+}
+
+// SetSPProfile registers stdUAP to decode the contents of the SP or RE field of its category (stdUAP.Category),
+// the contents are read as a record: a FSPEC followed by the items of stdUAP.
+// e.g. d.SetSPProfile(uap.RE, reUAP) decodes the Reserved Expansion field of the category reUAP.Category.
+func (d *Decoder) SetSPProfile(fieldType uap.TypeField, stdUAP uap.StandardUAP) error {
+	return d.SetSPDecoder(stdUAP.Category, fieldType, func(data []byte) ([]Item, error) {
+		rec := new(Record)
+		unRead, err := rec.Decode(data, stdUAP)
+		if err != nil {
+			return nil, err
+		}
+		if unRead != 0 {
+			return nil, ErrItemMalformed
+		}
+		return rec.Items, nil
+	})
+}
+
+// RemoveSPDecoder removes the sub-decoder of the SP or RE field of the category,
+// its contents are then returned as opaque bytes.
+func (d *Decoder) RemoveSPDecoder(category uint8, fieldType uap.TypeField) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.spDecoders, spKey{category: category, fieldType: fieldType})
+}
+
+// DecodeSpecialPurpose fills the items of the SP and RE fields of the record with the sub-decoders of the Decoder,
+// e.g. for a record decoded by Record.Decode. A field without sub-decoder is left unchanged.
+// An error of a sub-decoder does not fail the record: the field keeps its Data, its Items are nil
+// and the error is reported in its Err.
+func (d *Decoder) DecodeSpecialPurpose(rec *Record) {
+	for i := range rec.Items {
+		item := &rec.Items[i]
+		if item.SP == nil || (item.Meta.Type != uap.SP && item.Meta.Type != uap.RE) {
+			continue
+		}
+		d.mu.RLock()
+		dec, found := d.spDecoders[spKey{category: rec.Cat, fieldType: item.Meta.Type}]
+		d.mu.RUnlock()
+		if !found {
+			continue
+		}
+
+		item.SP.Items, item.SP.Err = dec(item.SP.Data)
+		if item.SP.Err != nil {
+			item.SP.Items = nil
+		}
+	}
+}
+
+// decodeSpecialPurpose decodes the SP and RE fields of the records of the data blocks, see DecodeSpecialPurpose.
+func (d *Decoder) decodeSpecialPurpose(blocks ...*DataBlock) {
+	d.mu.RLock()
+	none := len(d.spDecoders) == 0
+	d.mu.RUnlock()
+	if none {
+		return
+	}
+	for _, db := range blocks {
+		for _, rec := range db.Records {
+			d.DecodeSpecialPurpose(rec)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package goasterix
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+// uapSPTest describes the contents of the SP field of the category for testing (Cat4Test).
+var uapSPTest = uap.StandardUAP{
+	Name:     "SP cat4test",
+	Category: 26,
+	Items: []uap.DataField{
+		{FRN: 1, DataItem: "SP/001", Description: "SP Fixed type field for test", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1}},
+		{FRN: 2, DataItem: "SP/002", Description: "SP Fixed type field for test", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1}},
+	},
+}
+
+func TestDecoder_SetSPDecoder(t *testing.T) {
+	// Arrange
+	dec := func(data []byte) ([]Item, error) { return nil, nil }
+	d := NewDecoder()
+	other := NewDecoder()
+
+	// Act
+	errSP := d.SetSPDecoder(26, uap.SP, dec)
+	errRE := d.SetSPDecoder(26, uap.RE, dec)
+	errFixed := d.SetSPDecoder(26, uap.Fixed, dec)
+
+	// Assert
+	if errSP != nil || errRE != nil {
+		t.Errorf("FAIL: err = %v, %v; Expected: %v", errSP, errRE, nil)
+	} else {
+		t.Logf("SUCCESS: err = %v, %v; Expected: %v", errSP, errRE, nil)
+	}
+	if errFixed != ErrDataFieldUnknown {
+		t.Errorf("FAIL: err = %v; Expected: %v", errFixed, ErrDataFieldUnknown)
+	} else {
+		t.Logf("SUCCESS: err = %v; Expected: %v", errFixed, ErrDataFieldUnknown)
+	}
+	if len(d.spDecoders) != 2 || len(other.spDecoders) != 0 {
+		t.Errorf("FAIL: sub-decoders = %v, %v; Expected: %v, %v", len(d.spDecoders), len(other.spDecoders), 2, 0)
+	} else {
+		t.Logf("SUCCESS: sub-decoders = %v, %v; Expected: %v, %v", len(d.spDecoders), len(other.spDecoders), 2, 0)
+	}
+	d.RemoveSPDecoder(26, uap.SP)
+	if len(d.spDecoders) != 1 {
+		t.Errorf("FAIL: sub-decoders = %v; Expected: %v", len(d.spDecoders), 1)
+	}
+}
+
+func TestDecoder_DecodeSpecialPurpose(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name  string
+		input string
+		err   error
+		items []Item
+	}
+	dataSet := []testCase{
+		{
+			Name:  "testcase 1: SP decoded by its profile",
+			input: "01 40 04 c0 ab cd",
+			err:   nil,
+			items: []Item{
+				{
+					Meta:  MetaItem{FRN: 1, DataItem: "SP/001", Description: "SP Fixed type field for test", Type: uap.Fixed},
+					Fixed: &Fixed{Data: []byte{0xab}},
+				},
+				{
+					Meta:  MetaItem{FRN: 2, DataItem: "SP/002", Description: "SP Fixed type field for test", Type: uap.Fixed},
+					Fixed: &Fixed{Data: []byte{0xcd}},
+				},
+			},
+		},
+		{
+			Name:  "testcase 2: SP contents truncated",
+			input: "01 40 03 c0 ab",
+			err:   io.EOF,
+			items: nil,
+		},
+		{
+			Name:  "testcase 3: SP contents too long",
+			input: "01 40 04 80 ab cd",
+			err:   ErrItemMalformed,
+			items: nil,
+		},
+	}
+
+	d := NewDecoder()
+	if err := d.SetSPProfile(uap.SP, uapSPTest); err != nil {
+		t.Fatalf("FAIL: err = %v; Expected: %v", err, nil)
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		input, _ := util.HexStringToByte(tc.input)
+		rec := new(Record)
+		unRead, err := rec.Decode(input, uap.Cat4Test)
+		if err != nil || unRead != 0 {
+			t.Fatalf("FAIL: %s - unRead = %v, err = %v; Expected: %v, %v", tc.Name, unRead, err, 0, nil)
+		}
+
+		// Act
+		d.DecodeSpecialPurpose(rec)
+
+		// Assert
+		sp := rec.Items[0].SP
+		if !errors.Is(sp.Err, tc.err) {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, sp.Err, tc.err)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, sp.Err, tc.err)
+		}
+		if !bytes.Equal(sp.Data, input[3:]) {
+			t.Errorf("FAIL: %s - data = % X; Expected: % X", tc.Name, sp.Data, input[3:])
+		} else {
+			t.Logf("SUCCESS: %s - data = % X; Expected: % X", tc.Name, sp.Data, input[3:])
+		}
+		if len(sp.Items) != len(tc.items) || (tc.items == nil && sp.Items != nil) {
+			t.Errorf("FAIL: %s - items = %v; Expected: %v", tc.Name, sp.Items, tc.items)
+			continue
+		}
+		for i, item := range sp.Items {
+			if item.Meta != tc.items[i].Meta || !bytes.Equal(item.Fixed.Data, tc.items[i].Fixed.Data) {
+				t.Errorf("FAIL: %s - item = %s; Expected: %s", tc.Name, item.String(), tc.items[i].String())
+			} else {
+				t.Logf("SUCCESS: %s - item = %s; Expected: %s", tc.Name, item.String(), tc.items[i].String())
+			}
+		}
+	}
+}
+
+func TestDecoder_Decode_SPDecoder(t *testing.T) {
+	// Arrange
+	input, _ := util.HexStringToByte("1a0008 01 40 03 ab cd")
+	errSP := errors.New("sp error")
+	dec := func(data []byte) ([]Item, error) {
+		if data[0] != 0xab {
+			return nil, errSP
+		}
+		return []Item{{Meta: MetaItem{DataItem: "SP/AB", Type: uap.Fixed}, Fixed: &Fixed{Data: data[1:]}}}, nil
+	}
+	d := NewDecoder()
+	d.SetProfile(uap.Cat4Test)
+	_ = d.SetSPDecoder(26, uap.SP, dec)
+	other := NewDecoder()
+	other.SetProfile(uap.Cat4Test)
+
+	// Act
+	db, _, err := d.Decode(input)
+	dbOther, _, errOther := other.Decode(input)
+	input[6] = 0x00
+	dbErr, _, errDec := d.Decode(input)
+
+	// Assert
+	sp := db.Records[0].Items[0].SP
+	if err != nil || sp.Err != nil || len(sp.Items) != 1 || sp.Items[0].Meta.DataItem != "SP/AB" {
+		t.Errorf("FAIL: err = %v, items = %v; Expected: %v, %s", err, sp.Items, nil, "SP/AB")
+	} else {
+		t.Logf("SUCCESS: err = %v, items = %v; Expected: %v, %s", err, sp.Items, nil, "SP/AB")
+	}
+	spOther := dbOther.Records[0].Items[0].SP
+	if errOther != nil || spOther.Items != nil {
+		t.Errorf("FAIL: err = %v, items = %v; Expected: %v, %v", errOther, spOther.Items, nil, nil)
+	} else {
+		t.Logf("SUCCESS: err = %v, items = %v; Expected: %v, %v", errOther, spOther.Items, nil, nil)
+	}
+	spErr := dbErr.Records[0].Items[0].SP
+	if errDec != nil || !errors.Is(spErr.Err, errSP) || spErr.Items != nil || !bytes.Equal(spErr.Data, input[6:]) {
+		t.Errorf("FAIL: err = %v, sp err = %v, data = % X; Expected: %v, %v, % X", errDec, spErr.Err, spErr.Data, nil, errSP, input[6:])
+	} else {
+		t.Logf("SUCCESS: err = %v, sp err = %v; Expected: %v, %v", errDec, spErr.Err, nil, errSP)
+	}
+}
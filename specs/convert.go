@@ -0,0 +1,392 @@
+package specs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// StandardUAP returns the User Application Profile of the category: its items ordered by the UAP, named
+// like "I048/010" and described by their title, with the types and sizes of their structure.
+// Several UAPs chosen by a selector (e.g. plot and track of CAT001) are converted to a conditional UAP,
+// the selector must be a sub-item in the first octet of an item common to all the UAPs.
+// The returned profile is validated, an error wraps ErrSpecsInvalid, ErrSpecsUnsupported or uap.ErrUAPInvalid.
+func (c *Category) StandardUAP() (uap.StandardUAP, error) {
+	if c.Edition.Major < 0 || c.Edition.Major > 0xFF || c.Edition.Minor < 0 || c.Edition.Minor > 0xFF {
+		return uap.StandardUAP{}, fmt.Errorf("%w: category %d edition %s", ErrSpecsInvalid, c.Number, c.Edition)
+	}
+	// Version is the float of the edition for compatibility, e.g. 1.3 for the edition 1.30
+	version, _ := strconv.ParseFloat(c.Edition.String(), 64)
+	stdUAP := uap.StandardUAP{
+		Name:     fmt.Sprintf("cat%03d_%s", c.Number, c.Edition),
+		Category: c.Number,
+		Version:  version,
+		Edition:  uap.Edition{Major: uint8(c.Edition.Major), Minor: uint8(c.Edition.Minor)},
+	}
+
+	var err error
+	switch c.UAP.Type {
+	case "uap", "":
+		stdUAP.Items, err = c.dataFields(c.UAP.Items, 1)
+	case "uaps":
+		stdUAP.Items, stdUAP.Condition, err = c.conditional()
+	default:
+		err = fmt.Errorf("%w: uap type %s", ErrSpecsInvalid, c.UAP.Type)
+	}
+	if err != nil {
+		return uap.StandardUAP{}, fmt.Errorf("category %d edition %s: %w", c.Number, c.Edition, err)
+	}
+	return stdUAP, stdUAP.Validate()
+}
+
+// uapEntries are the entries of a UAP which are not items of the catalogue, e.g. "rfs" for
+// the Random Field Sequencing of CAT001 and CAT002.
+var uapEntries = map[string]uap.DataField{
+	"-":   {DataItem: "NA", Type: uap.Spare},
+	"rfs": {DataItem: "Random Field Sequencing", Type: uap.RFS},
+}
+
+// dataFields returns the data fields of the items of the catalogue named by names, numbered from the FRN first.
+// A nil name is an unused FRN, it is converted to a Spare data field, the other entries which are not items of
+// the catalogue (see uapEntries) are converted to their data field.
+func (c *Category) dataFields(names []*string, first int) ([]uap.DataField, error) {
+	var fields []uap.DataField
+	for i, name := range names {
+		frn := uint8(first + i)
+		if name == nil || *name == "" {
+			fields = append(fields, uap.DataField{FRN: frn, DataItem: "NA", Type: uap.Spare})
+			continue
+		}
+		item, found := c.Lookup(*name)
+		if !found {
+			if field, ok := uapEntries[strings.ToLower(*name)]; ok {
+				field.FRN = frn
+				fields = append(fields, field)
+				continue
+			}
+			return nil, fmt.Errorf("%w: item %s of UAP not in catalogue", ErrSpecsInvalid, *name)
+		}
+		v, err := item.variation()
+		if err != nil {
+			return nil, err
+		}
+		field, err := dataField(frn, fmt.Sprintf("I%03d/%s", c.Number, item.Name), item.Title, v)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// conditional returns the items common to the UAPs, up to the item of the selector,
+// and the condition selecting the remaining items of each UAP.
+func (c *Category) conditional() ([]uap.DataField, *uap.Condition, error) {
+	sel := c.UAP.Selector
+	if sel == nil || len(sel.Item) != 2 || len(c.UAP.Variations) == 0 {
+		return nil, nil, fmt.Errorf("%w: uaps without selector of a sub-item", ErrSpecsUnsupported)
+	}
+
+	common := c.UAP.Variations[0].Items
+	pos := -1
+	for i, name := range common {
+		if name != nil && *name == sel.Item[0] {
+			pos = i
+			break
+		}
+	}
+	if pos < 0 {
+		return nil, nil, fmt.Errorf("%w: selector %s not in UAP", ErrSpecsInvalid, sel.Item[0])
+	}
+	for _, v := range c.UAP.Variations {
+		if len(v.Items) <= pos || !sameNames(v.Items[:pos+1], common[:pos+1]) {
+			return nil, nil, fmt.Errorf("%w: UAP %s does not share the items up to the selector", ErrSpecsUnsupported, v.Name)
+		}
+	}
+
+	items, err := c.dataFields(common[:pos+1], 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	item, _ := c.Lookup(sel.Item[0])
+	mask, shift, err := selectorMask(item, sel.Item[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cond := &uap.Condition{FRN: uint8(pos + 1), Mask: mask}
+	for _, cs := range sel.Cases {
+		var variant *UAPVariant
+		for i := range c.UAP.Variations {
+			if c.UAP.Variations[i].Name == cs.Name {
+				variant = &c.UAP.Variations[i]
+			}
+		}
+		if variant == nil {
+			return nil, nil, fmt.Errorf("%w: UAP %s of selector not found", ErrSpecsInvalid, cs.Name)
+		}
+		fields, err := c.dataFields(variant.Items[pos+1:], pos+2)
+		if err != nil {
+			return nil, nil, err
+		}
+		cond.Variants = append(cond.Variants, uap.Variant{Value: uint8(cs.Value) << shift, Items: fields})
+	}
+	return items, cond, nil
+}
+
+// selectorMask returns the mask of the sub-item named name in the first octet of the item
+// and the shift of its value in this octet.
+func selectorMask(item *Item, name string) (uint8, uint, error) {
+	v, err := item.variation()
+	if err != nil {
+		return 0, 0, err
+	}
+	offset := 0
+	for _, sub := range v.Items {
+		if sub == nil {
+			break // FX bit of the first part
+		}
+		size := sub.Length
+		if !sub.Spare {
+			sv, err := sub.variation()
+			if err != nil {
+				return 0, 0, err
+			}
+			size, err = bitSize(sv)
+			if err != nil {
+				return 0, 0, err
+			}
+		}
+		if !sub.Spare && sub.Name == name {
+			if offset+size > 8 {
+				break
+			}
+			shift := uint(8 - offset - size)
+			return uint8((1<<uint(size))-1) << shift, shift, nil
+		}
+		offset += size
+	}
+	return 0, 0, fmt.Errorf("%w: selector %s/%s not in the first octet", ErrSpecsUnsupported, item.Name, name)
+}
+
+// sameNames returns true if a and b name the same items.
+func sameNames(a, b []*string) bool {
+	for i := range a {
+		if (a[i] == nil) != (b[i] == nil) || (a[i] != nil && *a[i] != *b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// dataField returns the data field of the item with the variation v.
+func dataField(frn uint8, dataItem string, title string, v *Variation) (uap.DataField, error) {
+	field := uap.DataField{FRN: frn, DataItem: dataItem, Description: title}
+	switch v.Type {
+	case "Element", "Group":
+		size, err := octets(v)
+		if err != nil {
+			return field, fmt.Errorf("item %s: %w", dataItem, err)
+		}
+		field.Type = uap.Fixed
+		field.Fixed.Size = size
+		if field.Subfields, err = elements(dataItem, title, v); err != nil {
+			return field, err
+		}
+
+	case "Extended":
+		primary, secondary, err := extendedSizes(v)
+		if err != nil {
+			return field, fmt.Errorf("item %s: %w", dataItem, err)
+		}
+		field.Type = uap.Extended
+		field.Extended = uap.ExtendedField{PrimarySize: primary, SecondarySize: secondary}
+		if field.Subfields, err = elements(dataItem, title, v); err != nil {
+			return field, err
+		}
+
+	case "Repetitive":
+		if v.Variation == nil {
+			return field, fmt.Errorf("%w: item %s: repetitive without variation", ErrSpecsInvalid, dataItem)
+		}
+		if v.Rep.Type == "Fx" {
+			bits, err := bitSize(v.Variation)
+			if err != nil {
+				return field, fmt.Errorf("item %s: %w", dataItem, err)
+			}
+			if bits%8 == 7 {
+				bits++ // the FX bit ends each repetition
+			}
+			if bits%8 != 0 {
+				return field, fmt.Errorf("%w: item %s: %d bits", ErrSpecsUnsupported, dataItem, bits)
+			}
+			field.Type = uap.RepetitiveFX
+			field.Repetitive.SubItemSize = uint8(bits / 8)
+			if field.Subfields, err = elements(dataItem, title, v.Variation); err != nil {
+				return field, err
+			}
+			break
+		}
+		if v.Rep.Size != 8 {
+			return field, fmt.Errorf("%w: item %s: REP of %d bits", ErrSpecsUnsupported, dataItem, v.Rep.Size)
+		}
+		field.Type = uap.Repetitive
+		if v.Variation.Type == "Compound" {
+			sub, err := dataField(0, dataItem, title, v.Variation)
+			if err != nil {
+				return field, err
+			}
+			field.Repetitive.Compound = sub.Compound
+			break
+		}
+		size, err := octets(v.Variation)
+		if err != nil {
+			return field, fmt.Errorf("item %s: %w", dataItem, err)
+		}
+		field.Repetitive.SubItemSize = size
+		if field.Subfields, err = elements(dataItem, title, v.Variation); err != nil {
+			return field, err
+		}
+
+	case "Explicit":
+		switch {
+		case strings.HasPrefix(string(v.Expl), "Special"):
+			field.Type = uap.SP
+		case strings.HasPrefix(string(v.Expl), "Reserved"):
+			field.Type = uap.RE
+		default:
+			field.Type = uap.Explicit
+		}
+
+	case "Compound":
+		if v.Fspec != nil {
+			return field, fmt.Errorf("%w: item %s: compound with a FSPEC of fixed size", ErrSpecsUnsupported, dataItem)
+		}
+		field.Type = uap.Compound
+		for i, sub := range v.Items {
+			frn := uint8(i + 1)
+			if sub == nil || sub.Spare {
+				field.Compound = append(field.Compound, uap.DataField{FRN: frn, Type: uap.Spare})
+				continue
+			}
+			sv, err := sub.variation()
+			if err != nil {
+				return field, err
+			}
+			subField, err := dataField(frn, sub.Name, sub.Title, sv)
+			if err != nil {
+				return field, fmt.Errorf("item %s: %w", dataItem, err)
+			}
+			field.Compound = append(field.Compound, subField)
+		}
+
+	default:
+		return field, fmt.Errorf("%w: item %s: variation %q", ErrSpecsInvalid, dataItem, v.Type)
+	}
+	return field, nil
+}
+
+// elements returns the sub-fields of the elements of the item with the variation v.
+func elements(dataItem string, title string, v *Variation) ([]uap.Subfield, error) {
+	subs, _, err := subfields(shortName(dataItem), title, v, 0)
+	if err != nil {
+		return nil, fmt.Errorf("item %s: %w", dataItem, err)
+	}
+	return subs, nil
+}
+
+// shortName returns the name of an item without its category, e.g. "010" for "I048/010".
+func shortName(dataItem string) string {
+	return dataItem[strings.LastIndex(dataItem, "/")+1:]
+}
+
+// bitSize returns the number of bits of an Element or a Group.
+func bitSize(v *Variation) (int, error) {
+	switch v.Type {
+	case "Element":
+		return v.Size, nil
+	case "Group":
+		n := 0
+		for _, sub := range v.Items {
+			if sub == nil {
+				return 0, fmt.Errorf("%w: group with FX bit", ErrSpecsInvalid)
+			}
+			if sub.Spare {
+				n += sub.Length
+				continue
+			}
+			sv, err := sub.variation()
+			if err != nil {
+				return 0, err
+			}
+			size, err := bitSize(sv)
+			if err != nil {
+				return 0, err
+			}
+			n += size
+		}
+		return n, nil
+	}
+	return 0, fmt.Errorf("%w: %s in a fixed size item", ErrSpecsUnsupported, v.Type)
+}
+
+// octets returns the number of octets of an Element or a Group.
+func octets(v *Variation) (uint8, error) {
+	bits, err := bitSize(v)
+	if err != nil {
+		return 0, err
+	}
+	if bits == 0 || bits%8 != 0 || bits/8 > 0xFF {
+		return 0, fmt.Errorf("%w: %d bits", ErrSpecsUnsupported, bits)
+	}
+	return uint8(bits / 8), nil
+}
+
+// extendedSizes returns the sizes in octets of the first part and of the extents of an Extended variation.
+// The extents must have the same size and all the parts must end with a FX bit.
+func extendedSizes(v *Variation) (uint8, uint8, error) {
+	if v.First != 0 {
+		if v.First%8 != 0 || v.Extents%8 != 0 || v.Extents == 0 {
+			return 0, 0, fmt.Errorf("%w: extended of %d/%d bits", ErrSpecsUnsupported, v.First, v.Extents)
+		}
+		return uint8(v.First / 8), uint8(v.Extents / 8), nil
+	}
+
+	var parts []int
+	bits := 0
+	for _, sub := range v.Items {
+		if sub == nil {
+			parts = append(parts, bits+1) // FX bit
+			bits = 0
+			continue
+		}
+		if sub.Spare {
+			bits += sub.Length
+			continue
+		}
+		sv, err := sub.variation()
+		if err != nil {
+			return 0, 0, err
+		}
+		size, err := bitSize(sv)
+		if err != nil {
+			return 0, 0, err
+		}
+		bits += size
+	}
+	if bits != 0 || len(parts) == 0 {
+		return 0, 0, fmt.Errorf("%w: extended whose last part has no FX bit", ErrSpecsUnsupported)
+	}
+	secondary := parts[0]
+	if len(parts) > 1 {
+		secondary = parts[1]
+	}
+	for i, p := range parts {
+		if p%8 != 0 || (i > 0 && p != secondary) {
+			return 0, 0, fmt.Errorf("%w: extended parts of %v bits", ErrSpecsUnsupported, parts)
+		}
+	}
+	return uint8(parts[0] / 8), uint8(secondary / 8), nil
+}
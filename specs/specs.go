@@ -0,0 +1,232 @@
+// Package specs reads the category definitions published in JSON by the asterix-specs project
+// (https://zoranbosnjak.github.io/asterix-specs) and converts them to User Application Profiles of package uap.
+//
+// A definition describes the catalogue of the data items of a category with their structure down to the bits
+// (elements, groups, extended, repetitive, explicit and compound items), their titles and their contents
+// (raw, table, quantity with unit...), and the UAP ordering the items in the FSPEC.
+// Only the structure of the items is needed to decode the category: Category.StandardUAP returns it,
+// with the elements as uap.Subfield (LSB, unit, signedness and table of values of their contents),
+// the other information is kept in Category as field-level metadata.
+package specs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+var (
+	// ErrSpecsInvalid reports that a definition does not follow the asterix-specs format.
+	ErrSpecsInvalid = errors.New("[ASTERIX] asterix-specs definition invalid")
+
+	// ErrSpecsUnsupported reports that a definition can not be represented by a uap.StandardUAP,
+	// e.g. an element which is not aligned on octets.
+	ErrSpecsUnsupported = errors.New("[ASTERIX] asterix-specs definition not supported by UAP")
+)
+
+// Category is the definition of an edition of an ASTERIX category.
+type Category struct {
+	Number    uint8   `json:"number"`
+	Title     string  `json:"title"`
+	Edition   Edition `json:"edition"`
+	Preamble  string  `json:"preamble"`
+	Catalogue []*Item `json:"catalogue"`
+	UAP       UAP     `json:"uap"`
+}
+
+// Edition is the version of a category definition, e.g. 1.31.
+type Edition struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+}
+
+func (e Edition) String() string {
+	return fmt.Sprintf("%d.%d", e.Major, e.Minor)
+}
+
+// Item is a data item of the catalogue or a sub-item of a group, an extended or a compound item.
+// A spare sub-item has no name, Length is its number of bits.
+// Its Variation is given by Rule, or directly by Variation in the older editions of the format.
+type Item struct {
+	Spare      bool       `json:"spare"`
+	Length     int        `json:"length"`
+	Name       string     `json:"name"`
+	Title      string     `json:"title"`
+	Definition string     `json:"definition"`
+	Remark     string     `json:"remark"`
+	Rule       *Rule      `json:"rule"`
+	Variation  *Variation `json:"variation"`
+}
+
+// Rule selects the variation of an item, ContextFree gives Value, Dependent depends on the value of other items
+// and gives Default otherwise.
+type Rule struct {
+	Type    string     `json:"type"`
+	Value   *Variation `json:"value"`
+	Default *Variation `json:"default"`
+}
+
+// Variation is the structure of an item, its Type is one of Element, Group, Extended, Repetitive, Explicit
+// or Compound.
+//   - Element: Size bits, Content describes the value (Raw, Table, Quantity...) and is kept as is.
+//   - Group: the sub-items Items follow each other.
+//   - Extended: the sub-items Items, a nil item marks a FX bit; the older editions give the size of the first part
+//     in First and of the extents in Extents (in bits, FX included).
+//   - Repetitive: Variation repeated, Rep gives the size of the REP factor or the FX repetition.
+//   - Explicit: Expl is the kind of contents, SpecialPurpose, ReservedExpansion or none.
+//   - Compound: the sub-items Items selected by the primary subfield, a nil item is an unused bit.
+type Variation struct {
+	Type      string          `json:"type"`
+	Size      int             `json:"size"`
+	Content   json.RawMessage `json:"rule"`
+	Items     []*Item         `json:"items"`
+	First     int             `json:"first"`
+	Extents   int             `json:"extents"`
+	Rep       Rep             `json:"rep"`
+	Variation *Variation      `json:"variation"`
+	Expl      Expl            `json:"expl"`
+	Fspec     *int            `json:"fspec"`
+}
+
+// Rep is the repetition of a Repetitive variation: Type is Regular with a REP factor of Size bits,
+// or Fx when each repetition ends with a FX bit. The older editions give the size of the REP factor only.
+type Rep struct {
+	Type string `json:"type"`
+	Size int    `json:"size"`
+}
+
+// UnmarshalJSON reads a Rep object or the size of the REP factor.
+func (r *Rep) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var size int
+	if err := json.Unmarshal(data, &size); err == nil {
+		*r = Rep{Type: "Regular", Size: size}
+		return nil
+	}
+	type rep Rep
+	return json.Unmarshal(data, (*rep)(r))
+}
+
+// Expl is the kind of contents of an Explicit variation: SpecialPurpose, ReservedExpansion or empty.
+type Expl string
+
+// UnmarshalJSON reads a kind given as a string, an object with a type or null.
+func (e *Expl) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*e = Expl(s)
+		return nil
+	}
+	var obj struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	*e = Expl(obj.Type)
+	return nil
+}
+
+// UAP orders the items of the catalogue in the FSPEC, a nil name is an unused FRN.
+// Type is uap for a single UAP, uaps for several UAPs given by Variations and chosen by Selector.
+type UAP struct {
+	Type       string       `json:"type"`
+	Items      []*string    `json:"items"`
+	Variations []UAPVariant `json:"variations"`
+	Selector   *Selector    `json:"selector"`
+}
+
+// UAPVariant is one of several UAPs of a category, e.g. plot or track.
+type UAPVariant struct {
+	Name  string    `json:"name"`
+	Items []*string `json:"items"`
+}
+
+// Selector chooses the UAP by the value of a sub-item, Item is its path (e.g. ["020", "TYP"])
+// and each case associates a value to the name of a UAP.
+type Selector struct {
+	Item  []string       `json:"item"`
+	Cases []SelectorCase `json:"cases"`
+}
+
+// SelectorCase associates the value of the selector to the name of a UAP.
+type SelectorCase struct {
+	Value int
+	Name  string
+}
+
+// UnmarshalJSON reads a case given as a pair [value, name].
+func (c *SelectorCase) UnmarshalJSON(data []byte) error {
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("%w: selector case %s", ErrSpecsInvalid, data)
+	}
+	if err := json.Unmarshal(pair[0], &c.Value); err != nil {
+		return err
+	}
+	return json.Unmarshal(pair[1], &c.Name)
+}
+
+// Read returns the category definition read from r in the JSON format of asterix-specs.
+func Read(r io.Reader) (*Category, error) {
+	cat := new(Category)
+	if err := json.NewDecoder(r).Decode(cat); err != nil {
+		return nil, err
+	}
+	if cat.Number == 0 || len(cat.Catalogue) == 0 {
+		return nil, fmt.Errorf("%w: no category number or catalogue", ErrSpecsInvalid)
+	}
+	return cat, nil
+}
+
+// ReadFile returns the category definition read from the JSON file of asterix-specs, e.g. cat048-1.31.json.
+func ReadFile(path string) (*Category, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cat, err := Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cat, nil
+}
+
+// Lookup returns the item of the catalogue identified by its name (e.g. "010") and true,
+// or nil and false when the catalogue does not contain it.
+func (c *Category) Lookup(name string) (*Item, bool) {
+	for _, item := range c.Catalogue {
+		if item.Name == name {
+			return item, true
+		}
+	}
+	return nil, false
+}
+
+// variation returns the variation of the item, the default one when it depends on other items.
+func (i *Item) variation() (*Variation, error) {
+	if i.Rule != nil {
+		switch {
+		case i.Rule.Value != nil:
+			return i.Rule.Value, nil
+		case i.Rule.Default != nil:
+			return i.Rule.Default, nil
+		}
+	}
+	if i.Variation != nil {
+		return i.Variation, nil
+	}
+	return nil, fmt.Errorf("%w: item %s without variation", ErrSpecsInvalid, i.Name)
+}
@@ -0,0 +1,378 @@
+package specs
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix"
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+// cat048Specs is an extract of the definition of CAT048 in the format of asterix-specs.
+const cat048Specs = `{
+	"number": 48,
+	"title": "Monoradar Target Reports",
+	"edition": {"major": 1, "minor": 31},
+	"preamble": "",
+	"catalogue": [
+		{"name": "010", "title": "Data Source Identifier", "definition": "", "remark": null, "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Group", "items": [
+				{"spare": false, "name": "SAC", "title": "System Area Code",
+					"rule": {"type": "ContextFree", "value": {"type": "Element", "size": 8, "rule": {"type": "ContextFree", "value": {"type": "Raw"}}}}},
+				{"spare": false, "name": "SIC", "title": "System Identification Code",
+					"rule": {"type": "ContextFree", "value": {"type": "Element", "size": 8, "rule": {"type": "ContextFree", "value": {"type": "Raw"}}}}}
+			]}}},
+		{"name": "140", "title": "Time-of-Day", "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Element", "size": 24,
+				"rule": {"type": "ContextFree", "value": {"type": "Quantity", "signedness": "Unsigned", "unit": "s",
+					"lsb": {"type": "Div", "numerator": {"type": "Integer", "value": 1},
+						"denominator": {"type": "Pow", "base": 2, "exponent": 7}}}}}}},
+		{"name": "020", "title": "Target Report Descriptor", "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Extended", "items": [
+				{"spare": false, "name": "TYP", "title": "", "rule": {"type": "ContextFree", "value": {"type": "Element", "size": 3,
+					"rule": {"type": "ContextFree", "value": {"type": "Table", "values": [[0, "No detection"], [1, "Single PSR detection"]]}}}}},
+				{"spare": false, "name": "SIM", "title": "", "rule": {"type": "ContextFree", "value": {"type": "Element", "size": 1}}},
+				{"spare": false, "name": "RDP", "title": "", "rule": {"type": "ContextFree", "value": {"type": "Element", "size": 1}}},
+				{"spare": false, "name": "SPI", "title": "", "rule": {"type": "ContextFree", "value": {"type": "Element", "size": 1}}},
+				{"spare": false, "name": "RAB", "title": "", "rule": {"type": "ContextFree", "value": {"type": "Element", "size": 1}}},
+				null,
+				{"spare": false, "name": "TST", "title": "", "rule": {"type": "ContextFree", "value": {"type": "Element", "size": 1}}},
+				{"spare": true, "length": 6},
+				null
+			]}}},
+		{"name": "130", "title": "Radar Plot Characteristics", "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Compound", "fspec": null, "items": [
+				{"spare": false, "name": "SRL", "title": "SSR Plot Runlength",
+					"rule": {"type": "ContextFree", "value": {"type": "Element", "size": 8}}},
+				null,
+				{"spare": false, "name": "SAM", "title": "Amplitude of Received Replies for M(SSR)",
+					"rule": {"type": "ContextFree", "value": {"type": "Element", "size": 8,
+						"rule": {"type": "ContextFree", "value": {"type": "Quantity", "signedness": {"type": "Signed"},
+							"lsb": {"type": "Integer", "value": 1}, "unit": "dBm"}}}}}
+			]}}},
+		{"name": "250", "title": "BDS Register Data", "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Repetitive", "rep": {"type": "Regular", "size": 8},
+				"variation": {"type": "Element", "size": 64}}}},
+		{"name": "510", "title": "Composed Track Number", "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Repetitive", "rep": {"type": "Fx"},
+				"variation": {"type": "Element", "size": 23}}}},
+		{"name": "SP", "title": "Special Purpose Field", "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Explicit", "expl": "SpecialPurpose"}}},
+		{"name": "RE", "title": "Reserved Expansion Field", "spare": false,
+			"rule": {"type": "ContextFree", "value": {"type": "Explicit", "expl": "ReservedExpansion"}}}
+	],
+	"uap": {"type": "uap", "items": ["010", "140", "020", null, "130", "250", "510", "SP", "RE"]}
+}`
+
+// cat001Specs is an extract of a category with plot and track UAPs in the format of asterix-specs.
+const cat001Specs = `{
+	"number": 1,
+	"title": "Monoradar Target Reports",
+	"edition": {"major": 1, "minor": 2},
+	"catalogue": [
+		{"name": "010", "title": "Data Source Identifier",
+			"variation": {"type": "Element", "size": 16}},
+		{"name": "020", "title": "Target Report Descriptor",
+			"variation": {"type": "Extended", "first": 8, "extents": 8, "items": [
+				{"name": "TYP", "title": "", "variation": {"type": "Element", "size": 1}},
+				{"name": "SIM", "title": "", "variation": {"type": "Element", "size": 1}},
+				{"name": "SSRPSR", "title": "", "variation": {"type": "Element", "size": 2}}
+			]}},
+		{"name": "040", "title": "Measured Position", "variation": {"type": "Element", "size": 32}},
+		{"name": "161", "title": "Track Plot Number", "variation": {"type": "Element", "size": 16}},
+		{"name": "030", "title": "Warning/Error Conditions",
+			"variation": {"type": "Repetitive", "rep": 8, "variation": {"type": "Element", "size": 8}}}
+	],
+	"uap": {
+		"type": "uaps",
+		"variations": [
+			{"name": "plot", "items": ["010", "020", "040", "030", "rfs"]},
+			{"name": "track", "items": ["010", "020", "161"]}
+		],
+		"selector": {"item": ["020", "TYP"], "cases": [[0, "plot"], [1, "track"]]}
+	}
+}`
+
+func TestCategory_StandardUAP(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name   string
+		input  string
+		output uap.StandardUAP
+	}
+	dataSet := []testCase{
+		{
+			Name:  "testcase 1: CAT048 all variations",
+			input: cat048Specs,
+			output: uap.StandardUAP{
+				Name:     "cat048_1.31",
+				Category: 48,
+				Version:  1.31,
+				Edition:  uap.Edition{Major: 1, Minor: 31},
+				Items: []uap.DataField{
+					{FRN: 1, DataItem: "I048/010", Description: "Data Source Identifier", Type: uap.Fixed,
+						Fixed: uap.FixedField{Size: 2},
+						Subfields: []uap.Subfield{
+							{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+							{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+						}},
+					{FRN: 2, DataItem: "I048/140", Description: "Time-of-Day", Type: uap.Fixed,
+						Fixed: uap.FixedField{Size: 3},
+						Subfields: []uap.Subfield{
+							{Name: "140", Description: "Time-of-Day", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+						}},
+					{FRN: 3, DataItem: "I048/020", Description: "Target Report Descriptor", Type: uap.Extended,
+						Extended: uap.ExtendedField{PrimarySize: 1, SecondarySize: 1},
+						Subfields: []uap.Subfield{
+							{Name: "TYP", Offset: 0, Width: 3, Values: []uap.ValueTable{
+								{Value: 0, Meaning: "No detection"},
+								{Value: 1, Meaning: "Single PSR detection"},
+							}},
+							{Name: "SIM", Offset: 3, Width: 1},
+							{Name: "RDP", Offset: 4, Width: 1},
+							{Name: "SPI", Offset: 5, Width: 1},
+							{Name: "RAB", Offset: 6, Width: 1},
+							{Name: "TST", Offset: 8, Width: 1},
+						}},
+					{FRN: 4, DataItem: "NA", Type: uap.Spare},
+					{FRN: 5, DataItem: "I048/130", Description: "Radar Plot Characteristics", Type: uap.Compound,
+						Compound: []uap.DataField{
+							{FRN: 1, DataItem: "SRL", Description: "SSR Plot Runlength", Type: uap.Fixed,
+								Fixed: uap.FixedField{Size: 1},
+								Subfields: []uap.Subfield{
+									{Name: "SRL", Description: "SSR Plot Runlength", Offset: 0, Width: 8},
+								}},
+							{FRN: 2, Type: uap.Spare},
+							{FRN: 3, DataItem: "SAM", Description: "Amplitude of Received Replies for M(SSR)",
+								Type: uap.Fixed, Fixed: uap.FixedField{Size: 1},
+								Subfields: []uap.Subfield{
+									{Name: "SAM", Description: "Amplitude of Received Replies for M(SSR)", Offset: 0, Width: 8,
+										Signed: true, LSB: 1, Unit: "dBm"},
+								}},
+						}},
+					{FRN: 6, DataItem: "I048/250", Description: "BDS Register Data", Type: uap.Repetitive,
+						Repetitive: uap.RepetitiveField{SubItemSize: 8},
+						Subfields: []uap.Subfield{
+							{Name: "250", Description: "BDS Register Data", Offset: 0, Width: 64},
+						}},
+					{FRN: 7, DataItem: "I048/510", Description: "Composed Track Number", Type: uap.RepetitiveFX,
+						Repetitive: uap.RepetitiveField{SubItemSize: 3},
+						Subfields: []uap.Subfield{
+							{Name: "510", Description: "Composed Track Number", Offset: 0, Width: 23},
+						}},
+					{FRN: 8, DataItem: "I048/SP", Description: "Special Purpose Field", Type: uap.SP},
+					{FRN: 9, DataItem: "I048/RE", Description: "Reserved Expansion Field", Type: uap.RE},
+				},
+			},
+		},
+		{
+			Name:  "testcase 2: CAT001 plot and track UAPs, older format",
+			input: cat001Specs,
+			output: uap.StandardUAP{
+				Name:     "cat001_1.2",
+				Category: 1,
+				Version:  1.2,
+				Edition:  uap.Edition{Major: 1, Minor: 2},
+				Items: []uap.DataField{
+					{FRN: 1, DataItem: "I001/010", Description: "Data Source Identifier", Type: uap.Fixed,
+						Fixed: uap.FixedField{Size: 2},
+						Subfields: []uap.Subfield{
+							{Name: "010", Description: "Data Source Identifier", Offset: 0, Width: 16},
+						}},
+					{FRN: 2, DataItem: "I001/020", Description: "Target Report Descriptor", Type: uap.Extended,
+						Extended: uap.ExtendedField{PrimarySize: 1, SecondarySize: 1},
+						Subfields: []uap.Subfield{
+							{Name: "TYP", Offset: 0, Width: 1},
+							{Name: "SIM", Offset: 1, Width: 1},
+							{Name: "SSRPSR", Offset: 2, Width: 2},
+						}},
+				},
+				Condition: &uap.Condition{
+					FRN:  2,
+					Mask: 0x80,
+					Variants: []uap.Variant{
+						{Value: 0x00, Items: []uap.DataField{
+							{FRN: 3, DataItem: "I001/040", Description: "Measured Position", Type: uap.Fixed,
+								Fixed: uap.FixedField{Size: 4},
+								Subfields: []uap.Subfield{
+									{Name: "040", Description: "Measured Position", Offset: 0, Width: 32},
+								}},
+							{FRN: 4, DataItem: "I001/030", Description: "Warning/Error Conditions", Type: uap.Repetitive,
+								Repetitive: uap.RepetitiveField{SubItemSize: 1},
+								Subfields: []uap.Subfield{
+									{Name: "030", Description: "Warning/Error Conditions", Offset: 0, Width: 8},
+								}},
+							{FRN: 5, DataItem: "Random Field Sequencing", Type: uap.RFS},
+						}},
+						{Value: 0x80, Items: []uap.DataField{
+							{FRN: 3, DataItem: "I001/161", Description: "Track Plot Number", Type: uap.Fixed,
+								Fixed: uap.FixedField{Size: 2},
+								Subfields: []uap.Subfield{
+									{Name: "161", Description: "Track Plot Number", Offset: 0, Width: 16},
+								}},
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		cat, err := Read(strings.NewReader(tc.input))
+		if err != nil {
+			t.Fatalf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+
+		// Act
+		stdUAP, err := cat.StandardUAP()
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+		if !reflect.DeepEqual(stdUAP, tc.output) {
+			t.Errorf("FAIL: %s - uap = %+v; Expected: %+v", tc.Name, stdUAP, tc.output)
+		} else {
+			t.Logf("SUCCESS: %s - uap = %v; Expected: %v", tc.Name, stdUAP.Name, tc.output.Name)
+		}
+	}
+}
+
+func TestCategory_StandardUAPError(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name  string
+		input string
+		err   error
+	}
+	dataSet := []testCase{
+		{
+			Name: "testcase 1: element not aligned on octets",
+			input: `{"number": 48, "edition": {"major": 1, "minor": 0},
+				"catalogue": [{"name": "010", "title": "", "variation": {"type": "Element", "size": 12}}],
+				"uap": {"type": "uap", "items": ["010"]}}`,
+			err: ErrSpecsUnsupported,
+		},
+		{
+			Name: "testcase 2: item of UAP not in catalogue",
+			input: `{"number": 48, "edition": {"major": 1, "minor": 0},
+				"catalogue": [{"name": "010", "title": "", "variation": {"type": "Element", "size": 16}}],
+				"uap": {"type": "uap", "items": ["010", "020"]}}`,
+			err: ErrSpecsInvalid,
+		},
+		{
+			Name: "testcase 3: extended without FX bit at the end",
+			input: `{"number": 48, "edition": {"major": 1, "minor": 0},
+				"catalogue": [{"name": "020", "title": "", "variation": {"type": "Extended", "items": [
+					{"name": "A", "variation": {"type": "Element", "size": 7}}, null,
+					{"name": "B", "variation": {"type": "Element", "size": 8}}]}}],
+				"uap": {"type": "uap", "items": ["020"]}}`,
+			err: ErrSpecsUnsupported,
+		},
+		{
+			Name: "testcase 4: selector not in the first octet",
+			input: `{"number": 1, "edition": {"major": 1, "minor": 0},
+				"catalogue": [{"name": "010", "title": "", "variation": {"type": "Group", "items": [
+					{"name": "A", "variation": {"type": "Element", "size": 8}},
+					{"name": "B", "variation": {"type": "Element", "size": 8}}]}}],
+				"uap": {"type": "uaps", "variations": [{"name": "x", "items": ["010"]}],
+					"selector": {"item": ["010", "B"], "cases": [[0, "x"]]}}}`,
+			err: ErrSpecsUnsupported,
+		},
+		{
+			Name: "testcase 5: variation unknown",
+			input: `{"number": 48, "edition": {"major": 1, "minor": 0},
+				"catalogue": [{"name": "010", "title": "", "variation": {"type": "Bitmap", "size": 16}}],
+				"uap": {"type": "uap", "items": ["010"]}}`,
+			err: ErrSpecsInvalid,
+		},
+		{
+			Name: "testcase 6: edition out of range",
+			input: `{"number": 48, "edition": {"major": 1, "minor": 256},
+				"catalogue": [{"name": "010", "title": "", "variation": {"type": "Element", "size": 16}}],
+				"uap": {"type": "uap", "items": ["010"]}}`,
+			err: ErrSpecsInvalid,
+		},
+		{
+			Name: "testcase 7: sub-item of an extended without variation, older format",
+			input: `{"number": 48, "edition": {"major": 1, "minor": 0},
+				"catalogue": [{"name": "020", "title": "", "variation": {"type": "Extended", "first": 8, "extents": 8,
+					"items": [{"name": "TYP", "variation": {"type": "Element", "size": 3}}, {"name": "SIM"}]}}],
+				"uap": {"type": "uap", "items": ["020"]}}`,
+			err: ErrSpecsInvalid,
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		cat, err := Read(strings.NewReader(tc.input))
+		if err != nil {
+			t.Fatalf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+
+		// Act
+		_, err = cat.StandardUAP()
+
+		// Assert
+		if !errors.Is(err, tc.err) {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		}
+	}
+}
+
+func TestCategory_StandardUAPEdition(t *testing.T) {
+	// Arrange
+	input := `{"number": 48, "edition": {"major": 1, "minor": 30},
+		"catalogue": [{"name": "010", "title": "", "variation": {"type": "Element", "size": 16}}],
+		"uap": {"type": "uap", "items": ["010"]}}`
+	output := uap.Edition{Major: 1, Minor: 30}
+	cat, _ := Read(strings.NewReader(input))
+
+	// Act
+	stdUAP, err := cat.StandardUAP()
+
+	// Assert
+	if err != nil || stdUAP.Edition != output {
+		t.Errorf("FAIL: err = %v, edition = %v; Expected: %v, %v", err, stdUAP.Edition, nil, output)
+	} else {
+		t.Logf("SUCCESS: err = %v, edition = %v; Expected: %v, %v", err, stdUAP.Edition, nil, output)
+	}
+	r := uap.NewRegistry(stdUAP, uap.Cat048V127)
+	if latest, _ := r.Latest(48); latest.Edition != output {
+		t.Errorf("FAIL: latest = %v; Expected: %v", latest.Edition, output)
+	} else {
+		t.Logf("SUCCESS: latest = %v; Expected: %v", latest.Edition, output)
+	}
+}
+
+func TestCategory_StandardUAPDecode(t *testing.T) {
+	// Arrange
+	cat, _ := Read(strings.NewReader(cat048Specs))
+	stdUAP, _ := cat.StandardUAP()
+	input, _ := util.HexStringToByte("e8 0836 429b52 a1 00 a0 01 02")
+	rec := new(goasterix.Record)
+
+	// Act
+	unRead, err := rec.Decode(input, stdUAP)
+
+	// Assert
+	if err != nil || unRead != 0 {
+		t.Errorf("FAIL: err = %v, unRead = %v; Expected: %v, %v", err, unRead, nil, 0)
+	} else {
+		t.Logf("SUCCESS: err = %v, unRead = %v; Expected: %v, %v", err, unRead, nil, 0)
+	}
+	sam, found := rec.SubItem("I048/130", "SAM")
+	if !found || sam.Fixed.Data[0] != 0x02 {
+		t.Errorf("FAIL: SAM = %v; Expected: %v", sam, "02")
+	} else {
+		t.Logf("SUCCESS: SAM = %v; Expected: %v", sam.String(), "02")
+	}
+}
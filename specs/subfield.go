@@ -0,0 +1,154 @@
+package specs
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// content is the description of the value of an Element: Raw, Table (Values) or Quantity (Signedness, Lsb, Unit).
+// It is given by a ContextFree rule (Value), or by a Dependent rule (Default), or directly in the older editions.
+type content struct {
+	Type       string              `json:"type"`
+	Value      *content            `json:"value"`
+	Default    *content            `json:"default"`
+	Signedness json.RawMessage     `json:"signedness"`
+	Lsb        json.RawMessage     `json:"lsb"`
+	Unit       string              `json:"unit"`
+	Values     [][]json.RawMessage `json:"values"`
+}
+
+// subfields returns the sub-fields of the elements of v from the bit offset and the offset following them.
+// An element is named after its item, name and title are those of the item of v.
+// The FX bits of an Extended variation are skipped, the sub-fields of more than 64 bits are ignored.
+// It returns an error wrapping ErrSpecsInvalid when an item has no variation.
+func subfields(name string, title string, v *Variation, offset int) ([]uap.Subfield, int, error) {
+	var subs []uap.Subfield
+	switch v.Type {
+	case "Element":
+		if v.Size > 0 && v.Size <= 64 {
+			sub := uap.Subfield{Name: name, Description: title, Offset: uint16(offset), Width: uint8(v.Size)}
+			describe(&sub, v.Content)
+			subs = append(subs, sub)
+		}
+		return subs, offset + v.Size, nil
+
+	case "Group", "Extended":
+		for _, item := range v.Items {
+			if v.Type == "Extended" && v.First != 0 && fxBit(v, offset) {
+				offset++
+			}
+			switch {
+			case item == nil:
+				offset++ // FX bit
+			case item.Spare:
+				offset += item.Length
+			default:
+				iv, err := item.variation()
+				if err != nil {
+					return nil, offset, err
+				}
+				var s []uap.Subfield
+				s, offset, err = subfields(item.Name, item.Title, iv, offset)
+				if err != nil {
+					return nil, offset, err
+				}
+				subs = append(subs, s...)
+			}
+		}
+	}
+	return subs, offset, nil
+}
+
+// fxBit returns true if the bit at offset is a FX bit of an Extended variation of an older edition,
+// whose parts are given by First and Extents.
+func fxBit(v *Variation, offset int) bool {
+	if offset == v.First-1 {
+		return true
+	}
+	return v.Extents > 0 && offset >= v.First && (offset-v.First)%v.Extents == v.Extents-1
+}
+
+// describe sets the signedness, the LSB, the unit and the table of values of sub from the content of its element.
+// The content which can not be read is ignored: it is metadata, the sub-field gives then the raw value.
+func describe(sub *uap.Subfield, raw json.RawMessage) {
+	if len(raw) == 0 {
+		return
+	}
+	c := new(content)
+	if err := json.Unmarshal(raw, c); err != nil {
+		return
+	}
+	for c.Value != nil || c.Default != nil {
+		if c.Value != nil {
+			c = c.Value
+		} else {
+			c = c.Default
+		}
+	}
+
+	switch c.Type {
+	case "Table":
+		for _, pair := range c.Values {
+			var vt uap.ValueTable
+			if len(pair) != 2 || json.Unmarshal(pair[0], &vt.Value) != nil || json.Unmarshal(pair[1], &vt.Meaning) != nil {
+				continue
+			}
+			sub.Values = append(sub.Values, vt)
+		}
+	case "Quantity":
+		sub.Signed = strings.HasPrefix(kind(c.Signedness), "Signed")
+		if lsb, ok := number(c.Lsb); ok {
+			sub.LSB = lsb
+		}
+		sub.Unit = c.Unit
+	}
+}
+
+// kind returns a name given as a string or as an object with a type.
+func kind(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var obj struct {
+		Type string `json:"type"`
+	}
+	_ = json.Unmarshal(raw, &obj)
+	return obj.Type
+}
+
+// number returns the value of a number given as a JSON number or as an expression:
+// Integer or Real (value), Div (numerator, denominator) and Pow (base, exponent).
+func number(raw json.RawMessage) (float64, bool) {
+	var f float64
+	if json.Unmarshal(raw, &f) == nil {
+		return f, true
+	}
+	var expr struct {
+		Type        string          `json:"type"`
+		Value       json.RawMessage `json:"value"`
+		Numerator   json.RawMessage `json:"numerator"`
+		Denominator json.RawMessage `json:"denominator"`
+		Base        json.RawMessage `json:"base"`
+		Exponent    json.RawMessage `json:"exponent"`
+	}
+	if json.Unmarshal(raw, &expr) != nil {
+		return 0, false
+	}
+	switch expr.Type {
+	case "Integer", "Real":
+		return number(expr.Value)
+	case "Div":
+		n, okN := number(expr.Numerator)
+		d, okD := number(expr.Denominator)
+		return n / d, okN && okD && d != 0
+	case "Pow":
+		b, okB := number(expr.Base)
+		e, okE := number(expr.Exponent)
+		return math.Pow(b, e), okB && okE
+	}
+	return 0, false
+}
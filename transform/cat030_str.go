@@ -239,8 +239,8 @@ func (data *Cat030STRModel) write(rec goasterix.Record) {
 // Ref: 7.3.11 VIT : Vitesse calculée dans le plan (coordonnées cartésiennes)
 func vitCal(data [4]byte) Vit {
 	var vit Vit
-	vit.X = float64(int16(data[0])<<8+int16(data[1])) * 0.000061035
-	vit.Y = float64(int16(data[2])<<8+int16(data[3])) * 0.000061035
+	vit.X = float64(int16(data[0])<<8+int16(data[1])) / 16384
+	vit.Y = float64(int16(data[2])<<8+int16(data[3])) / 16384
 	return vit
 }
 
@@ -262,8 +262,7 @@ func flp(data [2]byte) Flstr {
 	}
 
 	tmp := uint16(data[0])<<8 + uint16(data[1])
-	tmp = tmp & 0x3FFF
-	niveauVol := goasterix.TwoComplement16(13, tmp)
+	niveauVol := signedInt(uint64(tmp&0x3FFF), 14)
 	flpm.NiveauVol = float64(niveauVol) / 4 // divide by 4 is in 100's feet
 
 	return flpm
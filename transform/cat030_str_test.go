@@ -20,17 +20,17 @@ func TestCat030STRModel_ToJsonRecord(t *testing.T) {
 		{
 			TestCaseName: "Testcase 1",
 			input:        "bfff0160 0885 5801b8 6092fc 010e 0200 0925f483 0c 04e6 04ea fb5ff9c4 f8 fd9a 0d0174 48455b 2cc371cf1de0",
-			output:       []byte(`{"sourceIdentifier":{"sac":8,"sic":133},"num":{"version":2,"nap":3,"st":"operational","ns":"principal","numero":220},"hptu":49445.96875,"pist":{"liv":"trafic_reel","cnf":"piste_confirmee","man":"defaut","tva":"defaut","type":"piste_association_multiple_primaire_secondaire","mort":"defaut","cre":"defaut","slr":"coordonnees_projetees_niveau_calcule","cor":"piste_non_correlee_plan_vol"},"alis":{"v":"code_valide","g":"defaut","c":"code_pas_changement","code":1000},"pos":{"x":36.578125,"y":-45.953125},"qual":6,"flpc":{"vc":"code_validated","gc":"default","niveauVol":313.5},"flpm":{"vc":"code_validated","gc":"default","niveauVol":314.5},"vit":{"x":-0.072326475,"y":-0.09741186},"mov":{"trans":"tendance_indeterminee","longi":"tendance_indeterminee","verti":"vol_descente"},"taux":-3597.65625,"spe":{"sy":1,"m":1,"s":0,"o1":0,"o2":0,"o3":0,"o4":0,"o5":0,"o6":0,"o7":1,"o8":0,"o9":1,"o10":1,"o11":1,"o12":0,"o13":0,"o14":0,"o15":0,"o16":0,"o17":0,"o18":0,"o19":0,"r":0,"c":0},"adrs":"48455B","ids":"KLM1317 "}`),
+			output:       []byte(`{"sourceIdentifier":{"sac":8,"sic":133},"num":{"version":2,"nap":3,"st":"operational","ns":"principal","numero":220},"hptu":49445.96875,"pist":{"liv":"trafic_reel","cnf":"piste_confirmee","man":"defaut","tva":"defaut","type":"piste_association_multiple_primaire_secondaire","mort":"defaut","cre":"defaut","slr":"coordonnees_projetees_niveau_calcule","cor":"piste_non_correlee_plan_vol"},"alis":{"v":"code_valide","g":"defaut","c":"code_pas_changement","code":1000},"pos":{"x":36.578125,"y":-45.953125},"qual":6,"flpc":{"vc":"code_validated","gc":"default","niveauVol":313.5},"flpm":{"vc":"code_validated","gc":"default","niveauVol":314.5},"vit":{"x":-0.07232666015625,"y":-0.097412109375},"mov":{"trans":"tendance_indeterminee","longi":"tendance_indeterminee","verti":"vol_descente"},"taux":-3597.65625,"spe":{"sy":1,"m":1,"s":0,"o1":0,"o2":0,"o3":0,"o4":0,"o5":0,"o6":0,"o7":1,"o8":0,"o9":1,"o10":1,"o11":1,"o12":0,"o13":0,"o14":0,"o15":0,"o16":0,"o17":0,"o18":0,"o19":0,"r":0,"c":0},"adrs":"48455B","ids":"KLM1317 "}`),
 		},
 		{
 			TestCaseName: "Testcase 2",
 			input:        "37fb7f604806f466ee0a094be45bc08c0e05f005f00540060cf0370b0252595234303537019e423733384d4c454d47454444484ca2aa4994b4c35de0",
-			output:       []byte(`{"num":{"version":2,"nap":1,"st":"operational","ns":"principal","numero":890},"hptu":52700.078125,"alis":{"v":"code_valide","g":"defaut","c":"code_pas_changement","code":4513},"pos":{"x":-110.578125,"y":-253.8125},"qual":7,"flpc":{"vc":"code_validated","gc":"default","niveauVol":380},"flpm":{"vc":"code_validated","gc":"default","niveauVol":380},"vit":{"x":0.08203104,"y":0.09448218},"mov":{"trans":"tendance_indeterminee","longi":"tendance_indeterminee","verti":"vol_palier"},"spe":{"sy":6,"m":1,"s":1,"o1":0,"o2":0,"o3":0,"o4":0,"o5":0,"o6":1,"o7":0,"o8":0,"o9":0,"o10":0,"o11":0,"o12":0,"o13":1,"o14":0,"o15":1,"o16":0,"o17":0,"o18":0,"o19":0,"r":0,"c":0},"ivol":"RYR4057","pln":414,"av":"B738","turb":"M","terd":"LEMG","tera":"EDDH","adrs":"4CA2AA","ids":"RYR4057 "}`),
+			output:       []byte(`{"num":{"version":2,"nap":1,"st":"operational","ns":"principal","numero":890},"hptu":52700.078125,"alis":{"v":"code_valide","g":"defaut","c":"code_pas_changement","code":4513},"pos":{"x":-110.578125,"y":-253.8125},"qual":7,"flpc":{"vc":"code_validated","gc":"default","niveauVol":380},"flpm":{"vc":"code_validated","gc":"default","niveauVol":380},"vit":{"x":0.08203125,"y":0.094482421875},"mov":{"trans":"tendance_indeterminee","longi":"tendance_indeterminee","verti":"vol_palier"},"spe":{"sy":6,"m":1,"s":1,"o1":0,"o2":0,"o3":0,"o4":0,"o5":0,"o6":1,"o7":0,"o8":0,"o9":0,"o10":0,"o11":0,"o12":0,"o13":1,"o14":0,"o15":1,"o16":0,"o17":0,"o18":0,"o19":0,"r":0,"c":0},"ivol":"RYR4057","pln":414,"av":"B738","turb":"M","terd":"LEMG","tera":"EDDH","adrs":"4CA2AA","ids":"RYR4057 "}`),
 		},
 		{
 			TestCaseName: "Testcase 3",
 			input:        "3ffb81604806e466ee0a090e0ecee134bee00e0154015401a20156f037090c08183465101826721724e0",
-			output:       []byte(`{"num":{"version":2,"nap":1,"st":"operational","ns":"principal","numero":882},"hptu":52700.078125,"pist":{"liv":"trafic_reel","cnf":"piste_confirmee","man":"defaut","tva":"defaut","type":"piste_monoradar_secondaire_pure","mort":"defaut","cre":"defaut","slr":"coordonnees_projetees_niveau_calcule","cor":"piste_non_correlee_plan_vol"},"alis":{"v":"code_valide","g":"defaut","c":"code_pas_changement","code":7316},"pos":{"x":-123.1875,"y":-260.5},"qual":7,"flpc":{"vc":"code_validated","gc":"default","niveauVol":85},"flpm":{"vc":"code_validated","gc":"default","niveauVol":85},"vit":{"x":0.02551263,"y":0.02087397},"mov":{"trans":"tendance_indeterminee","longi":"tendance_indeterminee","verti":"vol_palier"},"spe":{"sy":6,"m":1,"s":1,"o1":0,"o2":0,"o3":0,"o4":0,"o5":0,"o6":0,"o7":1,"o8":1,"o9":0,"o10":0,"o11":0,"o12":0,"o13":0,"o14":0,"o15":1,"o16":0,"o17":0,"o18":0,"o19":0,"r":0,"c":0},"radSacSic":{"sac":8,"sic":24},"adrs":"346510","ids":"FBY2E2S "}`),
+			output:       []byte(`{"num":{"version":2,"nap":1,"st":"operational","ns":"principal","numero":882},"hptu":52700.078125,"pist":{"liv":"trafic_reel","cnf":"piste_confirmee","man":"defaut","tva":"defaut","type":"piste_monoradar_secondaire_pure","mort":"defaut","cre":"defaut","slr":"coordonnees_projetees_niveau_calcule","cor":"piste_non_correlee_plan_vol"},"alis":{"v":"code_valide","g":"defaut","c":"code_pas_changement","code":7316},"pos":{"x":-123.1875,"y":-260.5},"qual":7,"flpc":{"vc":"code_validated","gc":"default","niveauVol":85},"flpm":{"vc":"code_validated","gc":"default","niveauVol":85},"vit":{"x":0.0255126953125,"y":0.0208740234375},"mov":{"trans":"tendance_indeterminee","longi":"tendance_indeterminee","verti":"vol_palier"},"spe":{"sy":6,"m":1,"s":1,"o1":0,"o2":0,"o3":0,"o4":0,"o5":0,"o6":0,"o7":1,"o8":1,"o9":0,"o10":0,"o11":0,"o12":0,"o13":0,"o14":0,"o15":1,"o16":0,"o17":0,"o18":0,"o19":0,"r":0,"c":0},"radSacSic":{"sac":8,"sic":24},"adrs":"346510","ids":"FBY2E2S "}`),
 		},
 	}
 	for _, row := range dataSet {
@@ -65,8 +65,8 @@ func TestCat030STRModel_VitCal(t *testing.T) {
 	// Arrange
 	input := [4]byte{0x27, 0x10, 0x27, 0x10}
 	output := Vit{
-		X: 0.6103500000000001,
-		Y: 0.6103500000000001,
+		X: 0.6103515625,
+		Y: 0.6103515625,
 	}
 
 	// Act
@@ -107,6 +107,24 @@ func TestCat030STRModel_Flp(t *testing.T) {
 				NiveauVol: 63.75,
 			},
 		},
+		{
+			TestCaseName: "testcase 3",
+			input:        [2]byte{0x17, 0x70}, // 0001 0111 0111 0000: FL 1500
+			output: Flstr{
+				Vc:        "code_validated",
+				Gc:        "default",
+				NiveauVol: 1500,
+			},
+		},
+		{
+			TestCaseName: "testcase 4",
+			input:        [2]byte{0x3f, 0xc4}, // 0011 1111 1100 0100: FL -15
+			output: Flstr{
+				Vc:        "code_validated",
+				Gc:        "default",
+				NiveauVol: -15,
+			},
+		},
 	}
 
 	for _, row := range dataSet {
@@ -530,8 +530,8 @@ func genericPolarWindow(data [8]byte) GenericPolarWindow {
 	var g GenericPolarWindow
 	g.RhoStart = float64(uint16(data[0])<<8+uint16(data[1])) / 256
 	g.RhoEnd = float64(uint16(data[2])<<8+uint16(data[3])) / 256
-	g.ThetaStart = float64(uint16(data[4])<<8+uint16(data[5])) * 0.0055
-	g.ThetaEnd = float64(uint16(data[6])<<8+uint16(data[7])) * 0.0055
+	g.ThetaStart = float64(uint16(data[4])<<8+uint16(data[5])) * 360 / 65536
+	g.ThetaEnd = float64(uint16(data[6])<<8+uint16(data[7])) * 360 / 65536
 	return g
 }
 
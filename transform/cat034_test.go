@@ -610,7 +610,7 @@ func TestCat034Model_GenericPolarWindow(t *testing.T) {
 		RhoStart:   0,
 		RhoEnd:     100,
 		ThetaStart: 0,
-		ThetaEnd:   55,
+		ThetaEnd:   float64(0x2710) * 360 / 65536,
 	}
 
 	// Act
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"github.com/mokhtarimokhtar/goasterix"
 	"github.com/mokhtarimokhtar/goasterix/commbds"
+	"github.com/mokhtarimokhtar/goasterix/uap"
 	"strconv"
 	"strings"
 )
@@ -81,11 +82,13 @@ type Cat048Model struct {
 	TrackStatus                   *Status               `json:"trackStatus,omitempty"`
 	BDSRegisterData               []*commbds.Bds        `json:"bdsRegisterData,omitempty"`
 	ComACASCapabilityFlightStatus *ACASCapaFlightStatus `json:"comAcasCapabilityFlightStatus,omitempty"`
+	Present                       Presence              `json:"-" xml:"-"`
 }
 
 // Write writes a single ASTERIX Record to Cat048Model.
 // Items is a slice of Items DataField.
 func (data *Cat048Model) write(rec goasterix.Record) {
+	data.Present = data.Present.with(rec)
 	for _, item := range rec.Items {
 		switch item.Meta.FRN {
 		case 1:
@@ -178,16 +181,142 @@ func (data *Cat048Model) write(rec goasterix.Record) {
 	}
 }
 
+// read reads a Cat048Model and returns the ASTERIX Record encoded with the UAP CAT048 v1.27.
+// It is the reverse of write: nil fields and zero values are considered as absent items, except the zero values
+// of the items in Present.
+// Only BDS registers not processed (CodeNotProcessed) or not valid (Code00) can be encoded.
+func (data *Cat048Model) read() (*goasterix.Record, error) {
+	var items []goasterix.Item
+	if data.SacSic != nil {
+		payload := sacSicPayload(*data.SacSic)
+		items = append(items, fixedItem(1, payload[:]))
+	}
+	if data.TimeOfDay != 0 || data.Present[2] {
+		payload, err := timeOfDayPayload(data.TimeOfDay)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(2, payload[:]))
+	}
+	if data.RhoTheta != nil {
+		payload, err := rhoThetaPayload(*data.RhoTheta)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(4, payload[:]))
+	}
+	if data.Mode3ACode != nil {
+		payload, err := mode3ACodeVGLPayload(*data.Mode3ACode)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(5, payload[:]))
+	}
+	if data.FlightLevel != nil {
+		payload, err := flightLevelPayload(*data.FlightLevel)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(6, payload[:]))
+	}
+	if data.RadarPlotCharacteristics != nil {
+		cp, err := radarPlotCharacteristicsPayload(*data.RadarPlotCharacteristics)
+		if err != nil {
+			return nil, err
+		}
+		if len(cp.Secondary) != 0 {
+			items = append(items, goasterix.Item{Meta: goasterix.MetaItem{FRN: 7}, Compound: cp})
+		}
+	}
+	if data.AircraftAddress != "" {
+		payload, err := hex.DecodeString(data.AircraftAddress)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(8, payload))
+	}
+	if data.AircraftIdentification != "" {
+		payload, err := modeSIdentificationPayload(data.AircraftIdentification)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(9, payload[:]))
+	}
+	if data.BDSRegisterData != nil {
+		payload, err := modeSMBDataPayload(data.BDSRegisterData)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, goasterix.Item{
+			Meta:       goasterix.MetaItem{FRN: 10},
+			Repetitive: &goasterix.Repetitive{Data: payload},
+		})
+	}
+	if data.TrackNumber != 0 || data.Present[11] {
+		payload := trackNumberPayload(data.TrackNumber)
+		items = append(items, fixedItem(11, payload[:]))
+	}
+	if data.CartesianXY != nil {
+		payload, err := cartesianXYPayload(*data.CartesianXY)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(12, payload[:]))
+	}
+	if data.TrackVelocity != nil {
+		payload, err := trackVelocityPayload(*data.TrackVelocity)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(13, payload[:]))
+	}
+	if data.TrackStatus != nil {
+		e, err := trackStatusPayload(*data.TrackStatus)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, goasterix.Item{Meta: goasterix.MetaItem{FRN: 14}, Extended: e})
+	}
+	if data.ComACASCapabilityFlightStatus != nil {
+		payload, err := comACASCapabilityFlightStatusPayload(*data.ComACASCapabilityFlightStatus)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(21, payload[:]))
+	}
+
+	rec := goasterix.NewRecord()
+	err := rec.Encode(uap.Cat048V127, items)
+	if err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
 // rhoTheta returns a slice [Rho,Theta] of float64,
-// Rho NM (1 bit = 1/256 NM). Theta deg (1 bit = approx. 0.0055°)
+// Rho NM (1 bit = 1/256 NM). Theta deg (1 bit = 360/2^16 deg, approx. 0.0055°)
 // Measured position of an aircraft in local polar co-ordinates.
 func rhoTheta(data [4]byte) PolarPosition {
 	var rt PolarPosition
 	rt.Rho = float64(uint16(data[0])<<8+uint16(data[1])) / 256
-	rt.Theta = float64(uint16(data[2])<<8+uint16(data[3])) * 0.0055
+	rt.Theta = float64(uint16(data[2])<<8+uint16(data[3])) * 360 / 65536
 	return rt
 }
 
+// rhoThetaPayload returns the four bytes of a PolarPosition, it is the reverse of rhoTheta.
+func rhoThetaPayload(rt PolarPosition) (data [4]byte, err error) {
+	rho, err := unsignedValue(rt.Rho, 1.0/256, 16)
+	if err != nil {
+		return data, err
+	}
+	theta, err := unsignedValue(rt.Theta, 360.0/65536, 16)
+	if err != nil {
+		return data, err
+	}
+	data = [4]byte{byte(rho >> 8), byte(rho), byte(theta >> 8), byte(theta)}
+	return data, nil
+}
+
 // mode3ACodeVGL returns codes VGL in order.
 // Squawk returns a string.
 // It converts Mode-3/A reply in octal representation to a string.
@@ -219,8 +348,25 @@ func mode3ACodeVGL(data [2]byte) Mode3A {
 	return mode3A
 }
 
+// mode3ACodeVGLPayload returns the two bytes of a Mode3A, it is the reverse of mode3ACodeVGL.
+// Squawk is an octal representation of 4 digits at most.
+func mode3ACodeVGLPayload(mode3A Mode3A) (data [2]byte, err error) {
+	squawk, err := strconv.ParseUint(mode3A.Squawk, 8, 12)
+	if err != nil {
+		return data, ErrValueUnknown
+	}
+
+	e := new(enumEncoder)
+	data[0] = e.enum(mode3A.V, 7, "code_validated", "code_not_validated") |
+		e.enum(mode3A.G, 6, "default", "garbled_code") |
+		e.enum(mode3A.L, 5, "code_derived_from_transponder", "code_not_extracted") |
+		byte(squawk>>8)
+	data[1] = byte(squawk)
+	return data, e.err
+}
+
 // flightLevel returns a float64 (1 bit = 1/4 FL).
-// Flight Level into binary representation converted in an integer (16bits).
+// Flight Level into binary representation converted in a signed integer (14 bits, two's complement).
 func flightLevel(data [2]byte) FL {
 	var fl FL
 	if data[0]&0x80 != 0 {
@@ -234,10 +380,25 @@ func flightLevel(data [2]byte) FL {
 		fl.G = "default"
 	}
 
-	fl.Level = float64((uint16(data[0])<<8+uint16(data[1]))&0x3FFF) / 4
+	fl.Level = float64(signedInt(uint64(data[0])<<8+uint64(data[1]), 14)) / 4
 	return fl
 }
 
+// flightLevelPayload returns the two bytes of a FL, it is the reverse of flightLevel.
+func flightLevelPayload(fl FL) (data [2]byte, err error) {
+	level, err := signedValue(fl.Level, 0.25, 14)
+	if err != nil {
+		return data, err
+	}
+
+	e := new(enumEncoder)
+	data[0] = e.enum(fl.V, 7, "code_validated", "code_not_validated") |
+		e.enum(fl.G, 6, "default", "garbled_code") |
+		byte(level>>8)
+	data[1] = byte(level)
+	return data, e.err
+}
+
 // radarPlotCharacteristics returns a map of float64,
 // It returns according to Primary Subfield (fspec).
 // SRL: SSR Plot Runlength (1 bits = 0.044 dg).
@@ -274,6 +435,39 @@ func radarPlotCharacteristics(cp goasterix.Compound) PlotCharacteristics {
 	return rpc
 }
 
+// radarPlotCharacteristicsPayload returns a Compound of PlotCharacteristics,
+// it is the reverse of radarPlotCharacteristics. A zero value is considered as an absent subfield.
+func radarPlotCharacteristicsPayload(rpc PlotCharacteristics) (*goasterix.Compound, error) {
+	cp := new(goasterix.Compound)
+	subItem := func(frn uint8, value uint64, err error) error {
+		if err == nil && value != 0 {
+			cp.Secondary = append(cp.Secondary, fixedItem(frn, []byte{byte(value)}))
+		}
+		return err
+	}
+
+	srl, err := unsignedValue(rpc.SRL, 0.044, 8)
+	if err = subItem(1, srl, err); err != nil {
+		return nil, err
+	}
+	_ = subItem(2, uint64(rpc.SRR), nil)
+	_ = subItem(3, uint64(uint8(rpc.SAM)), nil)
+	prl, err := unsignedValue(rpc.PRL, 0.044, 8)
+	if err = subItem(4, prl, err); err != nil {
+		return nil, err
+	}
+	_ = subItem(5, uint64(uint8(rpc.PAM)), nil)
+	rpd, err := signedValue(rpc.RPD, 1.0/256, 8)
+	if err = subItem(6, rpd, err); err != nil {
+		return nil, err
+	}
+	apd, err := signedValue(rpc.APD, 0.021972656, 8)
+	if err = subItem(7, apd, err); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
 type ModeSMB struct {
 	Rep  uint8
 	BDSs []*commbds.Bds
@@ -305,6 +499,34 @@ func modeSMBData(item goasterix.Repetitive) (msb []*commbds.Bds, err error) {
 	return msb, err
 }
 
+// modeSMBDataPayload returns the repetitive data of BDS registers, it is the reverse of modeSMBData.
+// The BDS registers decoded (e.g. Code40, Code50, Code60) cannot be encoded, they return ErrValueUnknown.
+func modeSMBDataPayload(bdss []*commbds.Bds) ([]byte, error) {
+	data := make([]byte, 0, len(bdss)*8)
+	for _, bds := range bdss {
+		code, err := strconv.ParseUint(bds.TransponderRegisterNumber, 16, 8)
+		if err != nil {
+			return nil, ErrValueUnknown
+		}
+
+		var mbData [7]byte
+		switch {
+		case bds.CodeNotProcessed != nil:
+			tmp, err := hex.DecodeString(*bds.CodeNotProcessed)
+			if err != nil || len(tmp) != 7 {
+				return nil, ErrValueUnknown
+			}
+			copy(mbData[:], tmp)
+		case bds.Code00 != nil:
+		default:
+			return nil, ErrValueUnknown
+		}
+		data = append(data, mbData[:]...)
+		data = append(data, byte(code))
+	}
+	return data, nil
+}
+
 // trackNumber returns an integer.
 // An integer value representing a unique reference to a track record within a particular track file.
 //func trackNumber(data [2]byte) (tn uint16, err error) {
@@ -320,16 +542,44 @@ func cartesianXY(data [4]byte) (pos CartesianXYPosition, err error) {
 	return pos, nil
 }
 
+// cartesianXYPayload returns the four bytes of a CartesianXYPosition, it is the reverse of cartesianXY.
+func cartesianXYPayload(pos CartesianXYPosition) (data [4]byte, err error) {
+	x, err := signedValue(pos.X, 1.0/128, 16)
+	if err != nil {
+		return data, err
+	}
+	y, err := signedValue(pos.Y, 1.0/128, 16)
+	if err != nil {
+		return data, err
+	}
+	data = [4]byte{byte(x >> 8), byte(x), byte(y >> 8), byte(y)}
+	return data, nil
+}
+
 // trackVelocity returns a slice [GroundSpeed,Heading] of float64.
 // GroundSpeed returns float64 NM/s (1 bit = 2^-14 NM/s).
-// Heading returns a float64 deg (1 bit = approx. 0.0055°).
+// Heading returns a float64 deg (1 bit = 360/2^16 deg, approx. 0.0055°).
 // Calculated track Velocity expressed in polar co-ordinates.
 func trackVelocity(data [4]byte) (v Velocity, err error) {
-	v.GroundSpeed = float64(uint16(data[0])<<8+uint16(data[1])) * 0.000061035
-	v.Heading = float64(uint16(data[2])<<8+uint16(data[3])) * 0.0055
+	v.GroundSpeed = float64(uint16(data[0])<<8+uint16(data[1])) / 16384
+	v.Heading = float64(uint16(data[2])<<8+uint16(data[3])) * 360 / 65536
 	return v, nil
 }
 
+// trackVelocityPayload returns the four bytes of a Velocity, it is the reverse of trackVelocity.
+func trackVelocityPayload(v Velocity) (data [4]byte, err error) {
+	gs, err := unsignedValue(v.GroundSpeed, 1.0/16384, 16)
+	if err != nil {
+		return data, err
+	}
+	hdg, err := unsignedValue(v.Heading, 360.0/65536, 16)
+	if err != nil {
+		return data, err
+	}
+	data = [4]byte{byte(gs >> 8), byte(gs), byte(hdg >> 8), byte(hdg)}
+	return data, nil
+}
+
 // trackStatus returns a map of uint8, CNF, RAD, DOU, MAH, CDM id exist: TRE, GHO, SUP, TCC.
 // Status of monoradar track (PSR and/or SSR updated).
 func trackStatus(item goasterix.Extended) Status {
@@ -404,6 +654,29 @@ func trackStatus(item goasterix.Extended) Status {
 	return ts
 }
 
+// trackStatusPayload returns an Extended of a Status, it is the reverse of trackStatus.
+// The first extent is present when one of TRE, GHO, SUP or TCC is not empty.
+func trackStatusPayload(ts Status) (*goasterix.Extended, error) {
+	e := new(enumEncoder)
+	tmp := new(goasterix.Extended)
+	tmp.Primary = []byte{
+		e.enum(ts.CNF, 7, "confirmed_track", "tentative_track") |
+			e.enum(ts.RAD, 5, "combined_track", "psr_track", "ssr_modes_track", "invalid") |
+			e.enum(ts.DOU, 4, "normal_confidence", "low_confidence") |
+			e.enum(ts.MAH, 3, "no_horizontal_man_sensed", "horizontal_man_sensed") |
+			e.enum(ts.CDM, 1, "maintaining", "climbing", "descending", "unknown"),
+	}
+	if ts.TRE != "" || ts.GHO != "" || ts.SUP != "" || ts.TCC != "" {
+		tmp.Secondary = []byte{
+			e.enum(ts.TRE, 7, "track_still_alive", "end_of_track_lifetime") |
+				e.enum(ts.GHO, 6, "true_target_track", "ghost_target_track") |
+				e.enum(ts.SUP, 5, "no", "yes") |
+				e.enum(ts.TCC, 4, "radar_plane", "slant_range_correction_used"),
+		}
+	}
+	return tmp, e.err
+}
+
 // todo: targetReportDescriptor
 
 //todo: method 5.2.3 Records Item I048/030, Warning/Error Conditions
@@ -507,3 +780,29 @@ func comACASCapabilityFlightStatus(data [2]byte) ACASCapaFlightStatus {
 
 	return a
 }
+
+// comACASCapabilityFlightStatusPayload returns the two bytes of an ACASCapaFlightStatus,
+// it is the reverse of comACASCapabilityFlightStatus.
+// COM and STAT "not_assigned" are encoded with the first value not assigned.
+func comACASCapabilityFlightStatusPayload(a ACASCapaFlightStatus) (data [2]byte, err error) {
+	b1b, err := strconv.ParseUint(a.B1B, 10, 4)
+	if a.B1B != "" && err != nil {
+		return data, ErrValueUnknown
+	}
+
+	e := new(enumEncoder)
+	data[0] = e.enum(a.COM, 5, "no_communications_capability", "comm_a_and_comm_b_capability",
+		"comm_a_and_comm_b_and_uplink_elm", "comm_a_and_comm_b_and_uplink_elm_and_downlink_elm",
+		"level_5_transponder_capability", "not_assigned") |
+		e.enum(a.STAT, 2, "no_alert_no_spi_aircraft_airborne", "no_alert_no_spi_aircraft_on_ground",
+			"alert_no_spi_aircraft_airborne", "alert_no_spi_aircraft_on_ground",
+			"alert_spi_aircraft_airborne_or_on_ground", "no_alert_spi_aircraft_airborne_or_on_ground",
+			"not_assigned", "unknown") |
+		e.enum(a.SI, 1, "si_code_capable", "sii_code_capable")
+	data[1] = e.enum(a.MSSC, 7, "no", "yes") |
+		e.enum(a.ARC, 6, "100_ft_resolution", "25_ft_resolution") |
+		e.enum(a.AIC, 5, "no", "yes") |
+		e.enum(a.B1A, 4, "0", "1") |
+		byte(b1b)
+	return data, e.err
+}
@@ -13,7 +13,7 @@ func TestCat048Model_ToJsonRecord(t *testing.T) {
 	// Arrange
 	// bds 02 e79a5d27a00c00 60 a3280030a40000 40
 	input := "ffff02 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 02e79a5d27a00c0060a3280030a4000040 063a 00800080 0743ce5b 40 20f5"
-	output := []byte(`{"sourceIdentifier":{"sac":8,"sic":54},"aircraftAddress":"490D01","aircraftIdentification":"NJE834H ","timeOfDay":34102.640625,"rhoTheta":{"rho":148.77734375,"theta":2.1174999999999997},"cartesianXY":{"x":1,"y":1},"flightLevel":{"v":"code_validated","g":"default","level":180},"radarPlotCharacteristics":{"srr":2,"sam":-73},"mode3ACode":{"squawk":"4423","v":"code_validated","g":"default","l":"code_derived_from_transponder"},"trackNumber":1594,"trackVelocity":{"groundSpeed":0.113464065,"heading":290.5485},"trackStatus":{"cnf":"confirmed_track","rad":"ssr_modes_track","dou":"normal_confidence","mah":"no_horizontal_man_sensed","cdm":"maintaining"},"bdsRegisterData":[{"transponderRegisterNumber":"60","code60":{"magneticHeading":-68,"indicatedAirspeed":302,"mach":0.632,"barometricAltitudeRate":32}},{"transponderRegisterNumber":"40","code40":{"mcpSelectAltitude":18000,"barometricPressureSetting":1013}}],"comAcasCapabilityFlightStatus":{"com":"comm_a_and_comm_b_capability","stat":"no_alert_no_spi_aircraft_airborne","si":"si_code_capable","mssc":"yes","arc":"25_ft_resolution","aic":"yes","b1a":"1","b1b":"5"}}`)
+	output := []byte(`{"sourceIdentifier":{"sac":8,"sic":54},"aircraftAddress":"490D01","aircraftIdentification":"NJE834H ","timeOfDay":34102.640625,"rhoTheta":{"rho":148.77734375,"theta":2.1148681640625},"cartesianXY":{"x":1,"y":1},"flightLevel":{"v":"code_validated","g":"default","level":180},"radarPlotCharacteristics":{"srr":2,"sam":-73},"mode3ACode":{"squawk":"4423","v":"code_validated","g":"default","l":"code_derived_from_transponder"},"trackNumber":1594,"trackVelocity":{"groundSpeed":0.11346435546875,"heading":290.1873779296875},"trackStatus":{"cnf":"confirmed_track","rad":"ssr_modes_track","dou":"normal_confidence","mah":"no_horizontal_man_sensed","cdm":"maintaining"},"bdsRegisterData":[{"transponderRegisterNumber":"60","code60":{"magneticHeading":-68,"indicatedAirspeed":302,"mach":0.632,"barometricAltitudeRate":32}},{"transponderRegisterNumber":"40","code40":{"mcpSelectAltitude":18000,"barometricPressureSetting":1013}}],"comAcasCapabilityFlightStatus":{"com":"comm_a_and_comm_b_capability","stat":"no_alert_no_spi_aircraft_airborne","si":"si_code_capable","mssc":"yes","arc":"25_ft_resolution","aic":"yes","b1a":"1","b1b":"5"}}`)
 
 	uap048 := uap.Cat048V127
 	data, _ := util.HexStringToByte(input)
@@ -43,7 +43,7 @@ func TestCat048Model_ToJsonRecord(t *testing.T) {
 func TestCat048Model_RhoTheta(t *testing.T) {
 	// Arrange
 	input := [4]byte{0xFF, 0xFF, 0xFF, 0xFF}
-	output := PolarPosition{Rho: float64(0xFFFF) / 256, Theta: float64(0xFFFF) * 0.0055}
+	output := PolarPosition{Rho: float64(0xFFFF) / 256, Theta: float64(0xFFFF) * 360 / 65536}
 
 	// Act
 	res := rhoTheta(input)
@@ -137,11 +137,11 @@ func TestCat048Model_FlightLevel(t *testing.T) {
 		},
 		{
 			TestCaseName: "testcase 4",
-			input:        [2]byte{0x3f, 0xff}, // 1100-1111 1111-1111
+			input:        [2]byte{0x3f, 0xff}, // 0011-1111 1111-1111
 			output: FL{
 				V:     "code_validated",
 				G:     "default",
-				Level: 4095.75,
+				Level: -0.25,
 			},
 		},
 	}
@@ -188,8 +188,8 @@ func TestCat048Model_TrackVelocity(t *testing.T) {
 	// Arrange
 	input := [4]byte{0x07, 0xc3, 0xdf, 0xc6}
 	output := Velocity{
-		GroundSpeed: 0.121276545,
-		Heading:     315.073,
+		GroundSpeed: float64(0x07c3) / 16384,
+		Heading:     float64(0xdfc6) * 360 / 65536,
 	}
 
 	// Act
@@ -534,3 +534,48 @@ func TestCat048Model_ComACASCapabilityFlightStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestCat048_Subfields(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name     string
+		dataItem string
+		subfield string
+		input    []byte
+		expected float64
+	}
+	rt := rhoTheta([4]byte{0x94, 0xc7, 0x01, 0x81})
+	fl := flightLevel([2]byte{0x02, 0xd0})
+	xy, _ := cartesianXY([4]byte{0xff, 0x80, 0x00, 0x80})
+	dataSet := []testCase{
+		{Name: "testcase 1: RHO", dataItem: "I048/040", subfield: "RHO", input: []byte{0x94, 0xc7, 0x01, 0x81}, expected: rt.Rho},
+		{Name: "testcase 2: FL", dataItem: "I048/090", subfield: "FL", input: []byte{0x02, 0xd0}, expected: fl.Level},
+		{Name: "testcase 3: X", dataItem: "I048/042", subfield: "X", input: []byte{0xff, 0x80, 0x00, 0x80}, expected: xy.X},
+		{Name: "testcase 4: Y", dataItem: "I048/042", subfield: "Y", input: []byte{0xff, 0x80, 0x00, 0x80}, expected: xy.Y},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		var subfield uap.Subfield
+		for _, field := range uap.Cat048V127.Items {
+			if field.DataItem != tc.dataItem {
+				continue
+			}
+			for _, sub := range field.Subfields {
+				if sub.Name == tc.subfield {
+					subfield = sub
+				}
+			}
+		}
+
+		// Act
+		value, ok := subfield.Value(tc.input)
+
+		// Assert
+		if !ok || value != tc.expected {
+			t.Errorf("FAIL: %s - value = %v, %v; Expected: %v", tc.Name, value, ok, tc.expected)
+		} else {
+			t.Logf("SUCCESS: %s - value = %v, %v; Expected: %v", tc.Name, value, ok, tc.expected)
+		}
+	}
+}
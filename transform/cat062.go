@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/mokhtarimokhtar/goasterix"
+	"github.com/mokhtarimokhtar/goasterix/uap"
 )
 
 type TrackVelocity struct {
@@ -91,6 +92,7 @@ type Cat062Model struct {
 	GeometricAltitude     float32              `json:"geometricAltitude,omitempty"`
 	BarometricAltitude    *BarometricAltitude  `json:"barometricAltitude,omitempty"`
 	RateOfClimbDescent    float32              `json:"rateOfClimbDescent,omitempty"`
+	Present               Presence             `json:"-" xml:"-"`
 }
 
 // todo case 14
@@ -108,6 +110,7 @@ type Cat062Model struct {
 // Write writes a single ASTERIX Record to Cat062Model.
 // CompoundItems is a slice of CompoundItems DataField.
 func (data *Cat062Model) write(rec goasterix.Record) {
+	data.Present = data.Present.with(rec)
 	for _, item := range rec.Items {
 		switch item.Meta.FRN {
 		case 1:
@@ -206,6 +209,131 @@ func (data *Cat062Model) write(rec goasterix.Record) {
 	}
 }
 
+// read reads a Cat062Model and returns the ASTERIX Record encoded with the UAP CAT062 v1.19.
+// It is the reverse of write: nil fields and zero values are considered as absent items, except the zero values
+// of the items in Present.
+func (data *Cat062Model) read() (*goasterix.Record, error) {
+	var items []goasterix.Item
+	if data.SacSic != nil {
+		payload := sacSicPayload(*data.SacSic)
+		items = append(items, fixedItem(1, payload[:]))
+	}
+	if data.ServiceIdentification != 0 || data.Present[3] {
+		items = append(items, fixedItem(3, []byte{data.ServiceIdentification}))
+	}
+	if data.TimeOfDay != 0 || data.Present[4] {
+		payload, err := timeOfDayPayload(data.TimeOfDay)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(4, payload[:]))
+	}
+	if data.TrackPositionWGS84 != nil {
+		payload, err := calculatedTrackPositionWGS84Payload(*data.TrackPositionWGS84)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(5, payload[:]))
+	}
+	if data.CartesianXY != nil {
+		payload, err := calculatedTrackPositionCartesianPayload(*data.CartesianXY)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(6, payload[:]))
+	}
+	if data.TrackVelocity != nil {
+		payload, err := calculatedTrackVelocityCartesianPayload(*data.TrackVelocity)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(7, payload[:]))
+	}
+	if data.Acceleration != nil {
+		payload, err := calculatedAccelerationCartesianPayload(*data.Acceleration)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(8, payload[:]))
+	}
+	if data.Mode3ACode != nil {
+		payload, err := mode3ACodePayload(*data.Mode3ACode)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(9, payload[:]))
+	}
+	if data.TargetIdentification != nil {
+		payload, err := targetIdentificationPayload(*data.TargetIdentification)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(10, payload[:]))
+	}
+	if data.AircraftDerivedData != nil {
+		cp, err := derivedDataPayload(*data.AircraftDerivedData)
+		if err != nil {
+			return nil, err
+		}
+		if len(cp.Secondary) != 0 {
+			items = append(items, goasterix.Item{Meta: goasterix.MetaItem{FRN: 11}, Compound: cp})
+		}
+	}
+	if data.TrackNumber != 0 || data.Present[12] {
+		payload := trackNumberPayload(data.TrackNumber)
+		items = append(items, fixedItem(12, payload[:]))
+	}
+	if data.TrackStatus != nil {
+		e, err := systemTrackStatusPayload(*data.TrackStatus)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, goasterix.Item{Meta: goasterix.MetaItem{FRN: 13}, Extended: e})
+	}
+	if data.ModeOfMovement != nil {
+		payload, err := modeOfMovementPayload(*data.ModeOfMovement)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(15, payload[:]))
+	}
+	if data.FlightLevel != 0 || data.Present[17] {
+		payload, err := signedPayload16(float64(data.FlightLevel), 0.25)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(17, payload[:]))
+	}
+	if data.GeometricAltitude != 0 || data.Present[18] {
+		payload, err := signedPayload16(float64(data.GeometricAltitude), 6.25)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(18, payload[:]))
+	}
+	if data.BarometricAltitude != nil {
+		payload, err := trackBarometricAltitudePayload(*data.BarometricAltitude)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(19, payload[:]))
+	}
+	if data.RateOfClimbDescent != 0 || data.Present[20] {
+		payload, err := signedPayload16(float64(data.RateOfClimbDescent), 6.25)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, fixedItem(20, payload[:]))
+	}
+
+	rec := goasterix.NewRecord()
+	err := rec.Encode(uap.Cat062V119, items)
+	if err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
 // extractModeOfMovement returns Calculated Mode of Movement of a target
 func extractModeOfMovement(data [1]byte) ModeMov {
 	var mov ModeMov
@@ -254,6 +382,16 @@ func extractModeOfMovement(data [1]byte) ModeMov {
 	return mov
 }
 
+// modeOfMovementPayload returns the byte of a ModeMov, it is the reverse of extractModeOfMovement.
+func modeOfMovementPayload(mov ModeMov) (data [1]byte, err error) {
+	e := new(enumEncoder)
+	data[0] = e.enum(mov.TRANS, 6, "constant_course", "right_turn", "left_turn", "undetermined") |
+		e.enum(mov.LONG, 4, "constant_groundspeed", "increasing_groundspeed", "decreasing_groundspeed", "undetermined") |
+		e.enum(mov.VERT, 2, "level", "climb", "descent", "undetermined") |
+		e.enum(mov.ADF, 1, "no_altitude_discrepancy", "altitude_discrepancy")
+	return data, e.err
+}
+
 type TrackStatus struct {
 	MON string `json:"mon"`
 	SPI string `json:"spi"`
@@ -548,6 +686,68 @@ func extractTrackStatus(item goasterix.Extended) TrackStatus {
 	return ts
 }
 
+// systemTrackStatusPayload returns an Extended of a TrackStatus, it is the reverse of extractTrackStatus.
+// An extent is present when one of its fields or of the following extents is not empty.
+func systemTrackStatusPayload(ts TrackStatus) (*goasterix.Extended, error) {
+	e := new(enumEncoder)
+	tmp := new(goasterix.Extended)
+	tmp.Primary = []byte{
+		e.enum(ts.MON, 7, "monosensor", "multisensor") |
+			e.enum(ts.SPI, 6, "default_value", "last_report_received") |
+			e.enum(ts.MRH, 5, "barometric_altitude_reliable", "geometric_altitude_reliable") |
+			e.enum(ts.SRC, 2, "no_source", "gnss", "3d_radar", "triangulation", "height_coverage",
+				"speed_look_up_table", "default_height", "multilateration") |
+			e.enum(ts.CNF, 1, "confirmed_track", "tentative_track"),
+	}
+
+	extents := [5]byte{
+		e.enum(ts.SIM, 7, "actual_track", "simulated_track") |
+			e.enum(ts.TSE, 6, "default_value", "last_message_transmitted") |
+			e.enum(ts.TSB, 5, "default_value", "first_message_transmitted") |
+			e.enum(ts.FPC, 4, "not_flight_plan_correlated", "flight_plan_correlated") |
+			e.enum(ts.AFF, 3, "default_value", "ads_b_data_inconsistent") |
+			e.enum(ts.STP, 2, "default_value", "slave_track_promotion") |
+			e.enum(ts.KOS, 1, "complementary_service_used", "background_service_used"),
+		e.enum(ts.AMA, 7, "track_not_resulting_amalgamation_process", "track_resulting_amalgamation_process") |
+			e.enum(ts.MD4, 5, "no_mode_4_interrogation", "friendly_target", "unknown_target", "no_reply") |
+			e.enum(ts.ME, 4, "default_value", "military_emergency_last_report_received") |
+			e.enum(ts.MI, 3, "default_value", "military_identification_last_report_received") |
+			e.enum(ts.MD5, 1, "no_mode_5_interrogation", "friendly_target", "unknown_target", "no_reply"),
+		e.enum(ts.CST, 7, "default_value", "age_last_track_higher_than_system_dependent_threshold") |
+			e.enum(ts.PSR, 6, "default_value", "age_last_psr_track_higher_than_system_dependent_threshold") |
+			e.enum(ts.SSR, 5, "default_value", "age_last_ssr_track_higher_than_system_dependent_threshold") |
+			e.enum(ts.MDS, 4, "default_value", "age_last_mode_s_track_higher_than_system_dependent_threshold") |
+			e.enum(ts.ADS, 3, "default_value", "age_last_ads_b_track_higher_than_system_dependent_threshold") |
+			e.enum(ts.SUC, 2, "default_value", "special_used_code") |
+			e.enum(ts.AAC, 1, "default_value", "assigned_mode_a_code_conflict"),
+		e.enum(ts.SDS, 6, "combined", "cooperative_only", "non_cooperative_only", "not_defined") |
+			e.enum(ts.EMS, 3, "no_emergency", "general_emergency", "lifeguard_medical", "minimum_fuel",
+				"no_communications", "unlawful_interference", "downed_aircraft", "undefined") |
+			e.enum(ts.PFT, 2, "no_indication", "potential_false_track_indication") |
+			e.enum(ts.FPLT, 1, "default_value", "track_created_updated_fpl_data"),
+		e.enum(ts.DUPT, 7, "default_value", "duplicate_mode_3a_code") |
+			e.enum(ts.DUPF, 6, "default_value", "duplicate_flight_plan") |
+			e.enum(ts.DUPM, 5, "default_value", "duplicate_flight_plan_manual_correlation") |
+			e.enum(ts.SFC, 4, "default_value", "surface_target") |
+			e.enum(ts.IDD, 3, "no_indication", "duplicate_flight_id") |
+			e.enum(ts.IEC, 2, "default_value", "inconsistent_emergency_code"),
+	}
+	present := [5]bool{
+		ts.TrackStatusFirstExtent != TrackStatusFirstExtent{},
+		ts.TrackStatusSecondExtent != TrackStatusSecondExtent{},
+		ts.TrackStatusThirdExtent != TrackStatusThirdExtent{},
+		ts.TrackStatusFourthExtent != TrackStatusFourthExtent{},
+		ts.TrackStatusFifthExtent != TrackStatusFifthExtent{},
+	}
+	for last := len(present) - 1; last >= 0; last-- {
+		if present[last] {
+			tmp.Secondary = extents[:last+1]
+			break
+		}
+	}
+	return tmp, e.err
+}
+
 // extractDerivedData returns Data derived directly by the aircraft.
 func extractDerivedData(cp goasterix.Compound) DerivedData {
 	var dd DerivedData
@@ -628,6 +828,95 @@ func extractDerivedData(cp goasterix.Compound) DerivedData {
 	return dd
 }
 
+// derivedDataPayload returns a Compound of DerivedData, it is the reverse of extractDerivedData.
+// A zero value is considered as an absent subfield.
+func derivedDataPayload(dd DerivedData) (*goasterix.Compound, error) {
+	cp := new(goasterix.Compound)
+	if dd.TargetAddress != "" {
+		payload, err := hex.DecodeString(dd.TargetAddress)
+		if err != nil {
+			return nil, err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(1, payload))
+	}
+	if dd.TargetIdentification != "" {
+		payload, err := modeSIdentificationPayload(dd.TargetIdentification)
+		if err != nil {
+			return nil, err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(2, payload[:]))
+	}
+	if dd.MagneticHeading != 0 {
+		tmp, err := unsignedValue(dd.MagneticHeading, 0.0055, 16)
+		if err != nil {
+			return nil, err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(3, []byte{byte(tmp >> 8), byte(tmp)}))
+	}
+	if dd.IndicatedAirspeedOld != nil {
+		e := new(enumEncoder)
+		im := e.enum(dd.IndicatedAirspeedOld.IM, 7, "ias", "mach")
+		if e.err != nil {
+			return nil, e.err
+		}
+		lsb := 0.000061035
+		if im != 0 {
+			lsb = 0.001
+		}
+		tmp, err := unsignedValue(dd.IndicatedAirspeedOld.AirSpeed, lsb, 15)
+		if err != nil {
+			return nil, err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(4, []byte{im | byte(tmp>>8), byte(tmp)}))
+	}
+	if dd.AirSpeed != 0 {
+		cp.Secondary = append(cp.Secondary, fixedItem(5, []byte{byte(dd.AirSpeed >> 8), byte(dd.AirSpeed)}))
+	}
+	if dd.SelectedAltitude != nil {
+		tmp, err := signedValue(dd.SelectedAltitude.Altitude, 25, 13)
+		if err != nil {
+			return nil, err
+		}
+		e := new(enumEncoder)
+		octet := e.enum(dd.SelectedAltitude.SAS, 7, "no_source_information_provided", "source_information_provided") |
+			e.enum(dd.SelectedAltitude.Source, 5, "unknown", "aircraft_altitude", "fcu_mcp_selected_altitude",
+				"fms_selected_altitude")
+		if e.err != nil {
+			return nil, e.err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(6, []byte{octet | byte(tmp>>8), byte(tmp)}))
+	}
+	if dd.StateSelectedAltitude != nil {
+		tmp, err := signedValue(dd.StateSelectedAltitude.Altitude, 25, 13)
+		if err != nil {
+			return nil, err
+		}
+		e := new(enumEncoder)
+		octet := e.enum(dd.StateSelectedAltitude.MV, 7, "manage_vertical_mode_not_active", "manage_vertical_mode_active") |
+			e.enum(dd.StateSelectedAltitude.AH, 6, "altitude_hold_not_active", "altitude_hold_active") |
+			e.enum(dd.StateSelectedAltitude.AM, 5, "approach_mode_not_active", "approach_mode_active")
+		if e.err != nil {
+			return nil, e.err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(7, []byte{octet | byte(tmp>>8), byte(tmp)}))
+	}
+	if dd.IndicatedAirSpeed != 0 {
+		tmp, err := unsignedValue(dd.IndicatedAirSpeed, 1, 16)
+		if err != nil {
+			return nil, err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(26, []byte{byte(tmp >> 8), byte(tmp)}))
+	}
+	if dd.MachNumber != 0 {
+		tmp, err := unsignedValue(dd.MachNumber, 0.008, 16)
+		if err != nil {
+			return nil, err
+		}
+		cp.Secondary = append(cp.Secondary, fixedItem(27, []byte{byte(tmp >> 8), byte(tmp)}))
+	}
+	return cp, nil
+}
+
 // calculatedTrackPositionWGS84 returns Latitude and Longitude.
 // Calculated Position in WGS-84 Co-ordinates with a resolution of 180/2^25 degrees
 func calculatedTrackPositionWGS84(data [8]byte) PositionWGS84 {
@@ -638,6 +927,25 @@ func calculatedTrackPositionWGS84(data [8]byte) PositionWGS84 {
 	return pos
 }
 
+// calculatedTrackPositionWGS84Payload returns the eight bytes of a PositionWGS84,
+// it is the reverse of calculatedTrackPositionWGS84.
+func calculatedTrackPositionWGS84Payload(pos PositionWGS84) (data [8]byte, err error) {
+	lsb := 180 / math.Pow(2, 25)
+	lat, err := signedValue(pos.Latitude, lsb, 32)
+	if err != nil {
+		return data, err
+	}
+	long, err := signedValue(pos.Longitude, lsb, 32)
+	if err != nil {
+		return data, err
+	}
+	for i := 0; i < 4; i++ {
+		data[i] = byte(lat >> (24 - 8*i))
+		data[i+4] = byte(long >> (24 - 8*i))
+	}
+	return data, nil
+}
+
 // calculatedTrackPositionCartesian returns X and Y float64 in m
 // Calculated position in Cartesian co-ordinates with a resolution of 0.5m
 // LSB = 0.5
@@ -653,6 +961,21 @@ func calculatedTrackPositionCartesian(data [6]byte) CartesianXYPosition {
 	return pos
 }
 
+// calculatedTrackPositionCartesianPayload returns the six bytes of a CartesianXYPosition,
+// it is the reverse of calculatedTrackPositionCartesian.
+func calculatedTrackPositionCartesianPayload(pos CartesianXYPosition) (data [6]byte, err error) {
+	x, err := signedValue(pos.X, 0.5, 24)
+	if err != nil {
+		return data, err
+	}
+	y, err := signedValue(pos.Y, 0.5, 24)
+	if err != nil {
+		return data, err
+	}
+	data = [6]byte{byte(x >> 16), byte(x >> 8), byte(x), byte(y >> 16), byte(y >> 8), byte(y)}
+	return data, nil
+}
+
 // calculatedTrackVelocityCartesian returns Vx and Vy float32 in m/s
 // Calculated track velocity expressed in Cartesian co-ordinates
 func calculatedTrackVelocityCartesian(data [4]byte) TrackVelocity {
@@ -662,6 +985,21 @@ func calculatedTrackVelocityCartesian(data [4]byte) TrackVelocity {
 	return vel
 }
 
+// calculatedTrackVelocityCartesianPayload returns the four bytes of a TrackVelocity,
+// it is the reverse of calculatedTrackVelocityCartesian.
+func calculatedTrackVelocityCartesianPayload(vel TrackVelocity) (data [4]byte, err error) {
+	vx, err := signedPayload16(float64(vel.Vx), 0.25)
+	if err != nil {
+		return data, err
+	}
+	vy, err := signedPayload16(float64(vel.Vy), 0.25)
+	if err != nil {
+		return data, err
+	}
+	data = [4]byte{vx[0], vx[1], vy[0], vy[1]}
+	return data, nil
+}
+
 // calculatedAccelerationCartesian returns Ax and Ay float32 in m/s^2.
 // Calculated Acceleration of the target expressed in Cartesian co-ordinates
 // LSB = 0.25 m/s^2
@@ -672,6 +1010,21 @@ func calculatedAccelerationCartesian(data [2]byte) Acceleration {
 	return acc
 }
 
+// calculatedAccelerationCartesianPayload returns the two bytes of an Acceleration,
+// it is the reverse of calculatedAccelerationCartesian.
+func calculatedAccelerationCartesianPayload(acc Acceleration) (data [2]byte, err error) {
+	ax, err := signedValue(float64(acc.Ax), 0.25, 8)
+	if err != nil {
+		return data, err
+	}
+	ay, err := signedValue(float64(acc.Ay), 0.25, 8)
+	if err != nil {
+		return data, err
+	}
+	data = [2]byte{byte(ax), byte(ay)}
+	return data, nil
+}
+
 // mode3ACode returns the squawk.
 // Mode-3/A code converted into octal representation
 func mode3ACode(data [2]byte) TrackMode3A {
@@ -700,6 +1053,23 @@ func mode3ACode(data [2]byte) TrackMode3A {
 	return mode3A
 }
 
+// mode3ACodePayload returns the two bytes of a TrackMode3A, it is the reverse of mode3ACode.
+// Squawk is an octal representation of 4 digits at most.
+func mode3ACodePayload(mode3A TrackMode3A) (data [2]byte, err error) {
+	squawk, err := strconv.ParseUint(mode3A.Squawk, 8, 12)
+	if err != nil {
+		return data, ErrValueUnknown
+	}
+
+	e := new(enumEncoder)
+	data[0] = e.enum(mode3A.V, 7, "code_validated", "code_not_validated") |
+		e.enum(mode3A.G, 6, "default", "garbled_code") |
+		e.enum(mode3A.CH, 5, "no_change", "changed") |
+		byte(squawk>>8)
+	data[1] = byte(squawk)
+	return data, e.err
+}
+
 // Target (aircraft or vehicle) identification in 8 characters
 func targetIdentification(data [7]byte) TargetIdent {
 	var target TargetIdent
@@ -721,6 +1091,20 @@ func targetIdentification(data [7]byte) TargetIdent {
 	return target
 }
 
+// targetIdentificationPayload returns the seven bytes of a TargetIdent, it is the reverse of targetIdentification.
+func targetIdentificationPayload(target TargetIdent) (data [7]byte, err error) {
+	ident, err := modeSIdentificationPayload(target.Target)
+	if err != nil {
+		return data, err
+	}
+
+	e := new(enumEncoder)
+	data[0] = e.enum(target.STI, 6, "downlinked_target", "callsign_not_downlinked_target",
+		"registration_not_downlinked_target", "invalid")
+	copy(data[1:], ident[:])
+	return data, e.err
+}
+
 // measuredFlightLevel returns level in 100's ft
 func measuredFlightLevel(data [2]byte) float32 {
 	fl := float32(int16(data[0])<<8+int16(data[1])) / 4
@@ -744,10 +1128,24 @@ func trackBarometricAltitude(data [2]byte) BarometricAltitude {
 	} else {
 		ba.QNH = "no_qnh_correction_applied"
 	}
-	ba.Altitude = float64((uint16(data[0])<<8+uint16(data[1]))&0x7FFF) / 4
+	ba.Altitude = float64(signedInt(uint64(data[0])<<8+uint64(data[1]), 15)) / 4
 	return ba
 }
 
+// trackBarometricAltitudePayload returns the two bytes of a BarometricAltitude,
+// it is the reverse of trackBarometricAltitude.
+func trackBarometricAltitudePayload(ba BarometricAltitude) (data [2]byte, err error) {
+	altitude, err := signedValue(ba.Altitude, 0.25, 15)
+	if err != nil {
+		return data, err
+	}
+
+	e := new(enumEncoder)
+	data[0] = e.enum(ba.QNH, 7, "no_qnh_correction_applied", "qnh_correction_applied") | byte(altitude>>8)
+	data[1] = byte(altitude)
+	return data, e.err
+}
+
 // rateOfClimbDescent returns a float32 in feet/minute
 // Calculated rate of Climb/Descent of an aircraft in feet/minute
 // A positive value indicates a climb, whereas a negative value indicates a descent.
@@ -755,3 +1153,14 @@ func rateOfClimbDescent(data [2]byte) float32 {
 	rate := float32(int16(data[0])<<8+int16(data[1])) * 6.25
 	return rate
 }
+
+// signedPayload16 returns the two bytes of value in two's complement form with the resolution lsb,
+// it is the reverse of measuredFlightLevel, trackGeometricAltitude and rateOfClimbDescent.
+func signedPayload16(value float64, lsb float64) (data [2]byte, err error) {
+	tmp, err := signedValue(value, lsb, 16)
+	if err != nil {
+		return data, err
+	}
+	data = [2]byte{byte(tmp >> 8), byte(tmp)}
+	return data, nil
+}
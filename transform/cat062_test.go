@@ -139,6 +139,14 @@ func TestCat062Model_TrackBarometricAltitude(t *testing.T) {
 				Altitude: 63.75,
 			},
 		},
+		{
+			TestCaseName: "testcase 3: negative altitude",
+			input:        [2]byte{0x7f, 0xfc},
+			output: BarometricAltitude{
+				QNH:      "no_qnh_correction_applied",
+				Altitude: -1,
+			},
+		},
 	}
 
 	for _, row := range dataset {
@@ -65,7 +65,7 @@ func (data *Cat063Model) write(rec goasterix.Record) {
 			data.ModeSRangeGainAndBias = tmp
 		case 8:
 			// I063/081 SSR/Mode S Azimuth Bias
-			data.SSRModeSAzimuthBias = float64(int16(item.Fixed.Data[0])<<8+int16(item.Fixed.Data[1])) * 0.0055
+			data.SSRModeSAzimuthBias = float64(int16(item.Fixed.Data[0])<<8+int16(item.Fixed.Data[1])) * 360 / 65536
 		case 9:
 			//Data Item I063/090, PSR Range Gain and Bias
 			tmp := new(PSRRange)
@@ -74,10 +74,10 @@ func (data *Cat063Model) write(rec goasterix.Record) {
 			data.PSRRangeGainAndBias = tmp
 		case 10:
 			//Data Item I063/091, PSR Azimuth Bias
-			data.PSRAzimuthBias = float64(int16(item.Fixed.Data[0])<<8+int16(item.Fixed.Data[1])) * 0.0055
+			data.PSRAzimuthBias = float64(int16(item.Fixed.Data[0])<<8+int16(item.Fixed.Data[1])) * 360 / 65536
 		case 11:
 			//Data Item I063/092, PSR Elevation Bias
-			data.PSRElevationBias = float64(int16(item.Fixed.Data[0])<<8+int16(item.Fixed.Data[1])) * 0.0055
+			data.PSRElevationBias = float64(int16(item.Fixed.Data[0])<<8+int16(item.Fixed.Data[1])) * 360 / 65536
 		}
 
 	}
@@ -3,11 +3,19 @@ package transform
 import (
 	"errors"
 	"math"
+
+	"github.com/mokhtarimokhtar/goasterix"
 )
 
 var (
 	// ErrCharUnknown reports which not found equivalent International Alphabet 5 char.
 	ErrCharUnknown = errors.New("[ASTERIX Error] char unknown")
+
+	// ErrValueUnknown reports which a value of a model has no equivalent binary representation.
+	ErrValueUnknown = errors.New("[ASTERIX Error] value unknown")
+
+	// ErrValueOutOfRange reports which a value of a model exceeds the range of its binary representation.
+	ErrValueOutOfRange = errors.New("[ASTERIX Error] value out of range")
 )
 
 type CartesianXYPosition struct {
@@ -15,6 +23,22 @@ type CartesianXYPosition struct {
 	Y float64 `json:"y"`
 }
 
+// Presence is the set of the FRNs of the items of the records written to a typed model (e.g. Cat048Model).
+// The read of the model encodes a field with a zero value, e.g. a TimeOfDay at midnight, when its FRN is
+// in the set, the field is considered as absent otherwise.
+type Presence map[uint8]bool
+
+// with returns p with the FRNs of the items of rec.
+func (p Presence) with(rec goasterix.Record) Presence {
+	if p == nil {
+		p = make(Presence, len(rec.Items))
+	}
+	for _, item := range rec.Items {
+		p[item.Meta.FRN] = true
+	}
+	return p
+}
+
 type SourceIdentifier struct {
 	Sac uint8 `json:"sac" xml:"sac"`
 	Sic uint8 `json:"sic" xml:"sic"`
@@ -133,3 +157,115 @@ func checkEqualLatLong(resultCoordinates WGS84Coordinates, actualCoordinates WGS
 func equalWithinErrorBounds(actualValue float64, targetValue float64, epsilon float64) bool {
 	return math.Abs(targetValue-actualValue) < epsilon
 }
+
+// fixedItem returns an Item of type Fixed identified by its FRN, it's used to encode a model.
+func fixedItem(frn uint8, data []byte) goasterix.Item {
+	return goasterix.Item{
+		Meta:  goasterix.MetaItem{FRN: frn},
+		Fixed: &goasterix.Fixed{Data: data},
+	}
+}
+
+// sacSicPayload returns the two bytes of a SourceIdentifier, it is the reverse of sacSic.
+func sacSicPayload(src SourceIdentifier) [2]byte {
+	return [2]byte{src.Sac, src.Sic}
+}
+
+// timeOfDayPayload returns the three bytes (1 bit = 1/128 s) of a time of day in second,
+// it is the reverse of timeOfDay.
+func timeOfDayPayload(tod float64) ([3]byte, error) {
+	var data [3]byte
+	tmp, err := unsignedValue(tod, 1.0/128, 24)
+	data[0] = byte(tmp >> 16)
+	data[1] = byte(tmp >> 8)
+	data[2] = byte(tmp)
+	return data, err
+}
+
+// trackNumberPayload returns the two bytes of a track number, it is the reverse of trackNumber.
+func trackNumberPayload(tn uint16) [2]byte {
+	return [2]byte{byte(tn >> 8), byte(tn)}
+}
+
+// modeSIdentificationPayload returns the six bytes of an Aircraft identification (8 characters of 6 bits),
+// it is the reverse of modeSIdentification. An identification shorter than 8 characters is padded with spaces.
+func modeSIdentificationPayload(s string) ([6]byte, error) {
+	var data [6]byte
+	if len(s) > 8 {
+		return data, ErrValueOutOfRange
+	}
+	for len(s) < 8 {
+		s = s + " "
+	}
+
+	var tmp uint64
+	for i := 0; i < 8; i++ {
+		ch, found := ia5Code(s[i : i+1])
+		if !found {
+			return data, ErrCharUnknown
+		}
+		tmp = tmp<<6 + uint64(ch)
+	}
+	for i := 5; i >= 0; i-- {
+		data[i] = byte(tmp)
+		tmp = tmp >> 8
+	}
+	return data, nil
+}
+
+// ia5Code returns the International Alphabet 5 code of a char, it is the reverse of TableIA5.
+func ia5Code(ch string) (uint8, bool) {
+	for code, str := range TableIA5 {
+		if str == ch {
+			return code, true
+		}
+	}
+	return 0, false
+}
+
+// unsignedValue returns the binary representation on nbBits of value with the resolution lsb.
+func unsignedValue(value float64, lsb float64, nbBits uint) (uint64, error) {
+	tmp := math.Round(value / lsb)
+	if tmp < 0 || tmp > float64(uint64(1)<<nbBits-1) {
+		return 0, ErrValueOutOfRange
+	}
+	return uint64(tmp), nil
+}
+
+// signedValue returns the binary representation in two's complement form on nbBits of value with the resolution lsb.
+func signedValue(value float64, lsb float64, nbBits uint) (uint64, error) {
+	tmp := math.Round(value / lsb)
+	limit := float64(uint64(1) << (nbBits - 1))
+	if tmp < -limit || tmp > limit-1 {
+		return 0, ErrValueOutOfRange
+	}
+	return uint64(int64(tmp)) & (uint64(1)<<nbBits - 1), nil
+}
+
+// signedInt returns the value of raw in two's complement form on its nbBits low bits,
+// it is the reverse of signedValue.
+func signedInt(raw uint64, nbBits uint) int64 {
+	shift := 64 - nbBits
+	return int64(raw<<shift) >> shift
+}
+
+// enumEncoder packs the enumerated values of a model into bits, it is the reverse of the switch statements
+// of the writers. The first error encountered is kept in err.
+type enumEncoder struct {
+	err error
+}
+
+// enum returns the index of value in values shifted by shift.
+// An empty value corresponds to the index 0.
+func (e *enumEncoder) enum(value string, shift uint8, values ...string) byte {
+	if value == "" || e.err != nil {
+		return 0
+	}
+	for i, v := range values {
+		if v == value {
+			return byte(i) << shift
+		}
+	}
+	e.err = ErrValueUnknown
+	return 0
+}
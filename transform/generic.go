@@ -0,0 +1,258 @@
+package transform
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/mokhtarimokhtar/goasterix"
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+// Fields is a tree of named fields of a record: the items named by their DataItem (e.g. "I048/040"),
+// the sub-items of a compound item named by their DataItem (e.g. "SRR") and the sub-fields of an item
+// named by the Name of their uap.Subfield (e.g. "RHO").
+// A value is a Fields, a []interface{} of the repetitions of a repetitive item or a scalar.
+type Fields map[string]interface{}
+
+// GenericModel is the model of a record of any category, built by Transform from the profile of its category:
+// the profile selected by Decoder for the record (see goasterix.Decoder.RecordProfile), i.e. the edition it was
+// decoded with, or the profile of its category in Profiles (uap.DefaultProfiles when Profiles is nil).
+// It is an alternative to the typed models of the categories (e.g. Cat048Model): its Items covers every item
+// of the record, only the items described by sub-fields in the profile have their values scaled, the other
+// items are hexadecimal strings. The profiles of uap.DefaultProfiles describe their items with sub-fields,
+// except the character strings (e.g. I048/240, CSN of I062/390), the items whose structure is implementation
+// dependent or repeated in each extent (e.g. I001/030, I002/050, I004/060), the items of CAT032 STR but
+// I032/010, and the Special Purpose and Reserved Expansion fields. uap.Cat030ArtasV70, uap.Cat030ArtasV62,
+// uap.Cat021v10 and uap.Cat4Test have no sub-fields.
+// The profiles converted from asterix-specs definitions (package specs) describe their items of the Element,
+// Group and Extended variations.
+type GenericModel struct {
+	Decoder  *goasterix.Decoder        `json:"-" xml:"-"`
+	Profiles map[uint8]uap.StandardUAP `json:"-" xml:"-"`
+	Category uint8                     `json:"category" xml:"category"`
+	Items    Fields                    `json:"items" xml:"items"`
+}
+
+func (data *GenericModel) write(rec goasterix.Record) {
+	data.Category = rec.Cat
+	if data.Decoder != nil {
+		stdUAP, _ := data.Decoder.RecordProfile(rec)
+		data.Items = Transform(rec, stdUAP)
+		return
+	}
+	profiles := data.Profiles
+	if profiles == nil {
+		profiles = uap.DefaultProfiles
+	}
+	data.Items = Transform(rec, profiles[rec.Cat])
+}
+
+// Transform returns the tree of the named fields of the record decoded with stdUAP.
+// The value of an item is:
+//   - for an item with sub-fields in the UAP: the Fields of its sub-fields, the absent extents of an Extended
+//     item omitted, a []interface{} of these Fields for each repetition of a Repetitive or RepetitiveFX item;
+//   - for a Compound item (or an Explicit item containing a compound): the Fields of its sub-items;
+//   - for a Repetitive item of compounds: a []interface{} of the Fields of the sub-items of each compound;
+//   - for a RFS item: the Fields of its fields;
+//   - for a SP or RE item decoded by a sub-decoder: the Fields of its items;
+//   - otherwise the hexadecimal string of its data, e.g. for an item without sub-fields in the UAP.
+//
+// stdUAP must be the profile the record was decoded with, e.g. returned by goasterix.Decoder.RecordProfile:
+// the sub-fields of another edition may not match the data of the items.
+//
+// The value of a sub-field is the meaning of its raw value when it has a table of values and the raw value is
+// in the table, its value scaled by its LSB (float64) when it has a LSB, or its raw value (int64 when signed,
+// uint64 otherwise).
+func Transform(rec goasterix.Record, stdUAP uap.StandardUAP) Fields {
+	tree := make(Fields)
+	fields := stdUAP.Items
+	cond := stdUAP.EffectiveCondition()
+	for _, item := range rec.Items {
+		field, _ := dataFieldByFRN(fields, item.Meta.FRN)
+		if value := itemValue(item, field, fields); value != nil {
+			tree[item.Meta.DataItem] = value
+		}
+		if cond != nil && item.Meta.FRN == cond.FRN {
+			if selected, found := selectVariant(cond, item); found {
+				fields = selected
+			}
+		}
+	}
+	return tree
+}
+
+// itemValue returns the value of the item with its data field, fields are the data fields of the UAP
+// used for the fields of a RFS item. It returns nil for a Spare item.
+func itemValue(item goasterix.Item, field uap.DataField, fields []uap.DataField) interface{} {
+	switch item.Meta.Type {
+	case uap.Fixed:
+		return dataValue(item.Fixed.Data, field.Subfields)
+
+	case uap.Extended:
+		data := append(append([]byte{}, item.Extended.Primary...), item.Extended.Secondary...)
+		return dataValue(data, field.Subfields)
+
+	case uap.Explicit:
+		if item.Explicit.Compound != nil {
+			return compoundValue(*item.Explicit.Compound, field.Explicit.Compound)
+		}
+		return dataValue(item.Explicit.Data, field.Subfields)
+
+	case uap.Repetitive:
+		var reps []interface{}
+		switch {
+		case item.Repetitive.Compounds != nil:
+			for _, cp := range item.Repetitive.Compounds {
+				reps = append(reps, compoundValue(cp, field.Repetitive.Compound))
+			}
+		case item.Repetitive.Rep != 0 && len(item.Repetitive.Data)%int(item.Repetitive.Rep) == 0:
+			size := len(item.Repetitive.Data) / int(item.Repetitive.Rep)
+			for j := 0; j < int(item.Repetitive.Rep); j++ {
+				reps = append(reps, dataValue(item.Repetitive.Data[j*size:(j+1)*size], field.Subfields))
+			}
+		}
+		return reps
+
+	case uap.RepetitiveFX:
+		var reps []interface{}
+		for _, e := range item.RepetitiveFX.Elements {
+			reps = append(reps, dataValue(e, field.Subfields))
+		}
+		return reps
+
+	case uap.Compound:
+		return compoundValue(*item.Compound, field.Compound)
+
+	case uap.RFS:
+		tree := make(Fields)
+		for _, rf := range item.RFS.Sequence {
+			f, _ := dataFieldByFRN(fields, rf.FRN)
+			if value := itemValue(rf.Field, f, nil); value != nil {
+				tree[rf.Field.Meta.DataItem] = value
+			}
+		}
+		return tree
+
+	case uap.SP, uap.RE:
+		if item.SP.Items == nil {
+			return hex.EncodeToString(item.SP.Data)
+		}
+		tree := make(Fields)
+		for _, sub := range item.SP.Items {
+			if value := itemValue(sub, uap.DataField{}, nil); value != nil {
+				tree[sub.Meta.DataItem] = value
+			}
+		}
+		return tree
+	}
+	return nil
+}
+
+// compoundValue returns the Fields of the sub-items of the compound, fields are its data fields.
+func compoundValue(cp goasterix.Compound, fields []uap.DataField) Fields {
+	tree := make(Fields)
+	for _, sub := range cp.Secondary {
+		field, _ := dataFieldByFRN(fields, sub.Meta.FRN)
+		if value := itemValue(sub, field, nil); value != nil {
+			tree[sub.Meta.DataItem] = value
+		}
+	}
+	return tree
+}
+
+// dataValue returns the Fields of the sub-fields contained in data, or the hexadecimal string of data
+// when there are no sub-fields.
+func dataValue(data []byte, subfields []uap.Subfield) interface{} {
+	if len(subfields) == 0 {
+		return hex.EncodeToString(data)
+	}
+	tree := make(Fields)
+	for _, sub := range subfields {
+		if value, ok := subfieldValue(data, sub); ok {
+			tree[sub.Name] = value
+		}
+	}
+	return tree
+}
+
+// subfieldValue returns the value of the sub-field in data and true, or nil and false when data does not contain it.
+func subfieldValue(data []byte, sub uap.Subfield) (interface{}, bool) {
+	if len(sub.Values) != 0 {
+		if meaning, ok := sub.Meaning(data); ok {
+			return meaning, true
+		}
+	}
+	switch {
+	case sub.LSB != 0:
+		return sub.Value(data)
+	case sub.Signed:
+		return sub.Int(data)
+	}
+	return sub.Raw(data)
+}
+
+// dataFieldByFRN returns the data field of the FRN in fields.
+func dataFieldByFRN(fields []uap.DataField, frn uint8) (uap.DataField, bool) {
+	for _, f := range fields {
+		if f.FRN == frn {
+			return f, true
+		}
+	}
+	return uap.DataField{}, false
+}
+
+// selectVariant returns the data fields of the variant of the conditional UAP selected by the first octet
+// of the discriminating item.
+func selectVariant(cond *uap.Condition, item goasterix.Item) ([]uap.DataField, bool) {
+	var data []byte
+	switch item.Meta.Type {
+	case uap.Fixed:
+		data = item.Fixed.Data
+	case uap.Extended:
+		data = item.Extended.Primary
+	}
+	if len(data) == 0 {
+		return nil, false
+	}
+	return cond.Select(data[0])
+}
+
+// MarshalXML encodes the fields as <field name="..."> elements sorted by name, the repetitions of a field
+// are encoded as successive elements with the same name.
+func (f Fields) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		values, repeated := f[name].([]interface{})
+		if !repeated {
+			values = []interface{}{f[name]}
+		}
+		for _, v := range values {
+			if err := marshalField(e, name, v); err != nil {
+				return err
+			}
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// marshalField encodes a field named name with the value v.
+func marshalField(e *xml.Encoder, name string, v interface{}) error {
+	start := xml.StartElement{
+		Name: xml.Name{Local: "field"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "name"}, Value: name}},
+	}
+	if sub, ok := v.(Fields); ok {
+		return sub.MarshalXML(e, start)
+	}
+	return e.EncodeElement(fmt.Sprint(v), start)
+}
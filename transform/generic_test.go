@@ -0,0 +1,409 @@
+package transform
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix"
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+// genericUAP is a conditional UAP of plots and tracks described by sub-fields.
+var genericUAP = uap.StandardUAP{
+	Name:     "cat250_test",
+	Category: 250,
+	Version:  1.0,
+	Items: []uap.DataField{
+		{FRN: 1, DataItem: "I250/020", Type: uap.Fixed, Fixed: uap.FixedField{Size: 1},
+			Subfields: []uap.Subfield{
+				{Name: "TYP", Offset: 0, Width: 1, Values: []uap.ValueTable{
+					{Value: 0, Meaning: "plot"},
+					{Value: 1, Meaning: "track"},
+				}},
+			}},
+	},
+	Condition: &uap.Condition{
+		FRN:  1,
+		Mask: 0x80,
+		Variants: []uap.Variant{
+			{Value: 0x00, Items: []uap.DataField{
+				{FRN: 2, DataItem: "I250/040", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2},
+					Subfields: []uap.Subfield{{Name: "RHO", Offset: 0, Width: 16, LSB: 1.0 / 256, Unit: "NM"}}},
+			}},
+			{Value: 0x80, Items: []uap.DataField{
+				{FRN: 2, DataItem: "I250/161", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2},
+					Subfields: []uap.Subfield{{Name: "TRN", Offset: 4, Width: 12}}},
+				{FRN: 3, DataItem: "I250/030", Type: uap.Repetitive, Repetitive: uap.RepetitiveField{SubItemSize: 1},
+					Subfields: []uap.Subfield{{Name: "W", Offset: 0, Width: 7}}},
+			}},
+		},
+	},
+}
+
+func TestTransform(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name   string
+		input  string
+		uap    uap.StandardUAP
+		output Fields
+	}
+	dataSet := []testCase{
+		{
+			Name:  "testcase 1: CAT048 with sub-fields",
+			input: "ffd702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 063a 0743ce5b 40 20f5",
+			uap:   uap.Cat048V127,
+			output: Fields{
+				"I048/010": Fields{"SAC": uint64(8), "SIC": uint64(54)},
+				"I048/140": Fields{"TOD": 34102.640625},
+				"I048/020": Fields{
+					"TYP": "Single ModeS Roll-Call",
+					"SIM": "Actual target report",
+					"RDP": "Report from RDP Chain 1",
+					"SPI": "Absence of SPI",
+					"RAB": "Report from aircraft transponder",
+				},
+				"I048/040": Fields{"RHO": 148.77734375, "THETA": 2.1148681640625},
+				"I048/070": Fields{
+					"V":      "Code validated",
+					"G":      "Default",
+					"L":      "Mode-3/A code derived from the reply of the transponder",
+					"MODE3A": uint64(04423),
+				},
+				"I048/090": Fields{"V": "Code validated", "G": "Default", "FL": 180.0},
+				"I048/130": Fields{"SRR": Fields{"SRR": uint64(2)}, "SAM": Fields{"SAM": int64(-73)}},
+				"I048/220": Fields{"ACADDR": uint64(0x490d01)},
+				"I048/240": "38a178cf4220",
+				"I048/161": Fields{"TRN": uint64(1594)},
+				"I048/200": Fields{"GSP": 0.11346435546875, "HDG": 290.1873779296875},
+				"I048/170": Fields{
+					"CNF": "Confirmed track",
+					"RAD": "SSR/Mode S track",
+					"DOU": "Normal confidence",
+					"MAH": "No horizontal man. sensed",
+					"CDM": "Maintaining",
+				},
+				"I048/230": Fields{
+					"COM":  "Comm. A and Comm. B capability",
+					"STAT": "No alert, no SPI, aircraft airborne",
+					"SI":   "SI-Code Capable",
+					"MSSC": "Yes",
+					"ARC":  "25 ft resolution",
+					"AIC":  "Yes",
+					"B1A":  uint64(1),
+					"B1B":  uint64(5),
+				},
+			},
+		},
+		{
+			Name:  "testcase 2: CAT034 with sub-fields",
+			input: "f6083602429b7110940028200094008000",
+			uap:   uap.Cat034V127,
+			output: Fields{
+				"I034/010": Fields{"SAC": uint64(8), "SIC": uint64(54)},
+				"I034/000": Fields{"MT": "Sector crossing message"},
+				"I034/030": Fields{"TOD": 34102.8828125},
+				"I034/020": Fields{"SN": 22.5},
+				"I034/050": Fields{
+					"COM": Fields{
+						"NOGO":   "System is released for operational use",
+						"RDPC":   "RDPC-1 selected",
+						"RDPR":   "Default situation",
+						"OVLRDP": "Default, no overload",
+						"OVLXMT": "Default, no overload",
+						"MSC":    "Monitoring system connected",
+						"TSV":    "Valid",
+					},
+					"PSR": Fields{
+						"ANT":  "Antenna 1",
+						"CHAB": "Channel A only selected",
+						"OVL":  "No overload",
+						"MSC":  "Monitoring system disconnected",
+					},
+					"MDS": Fields{
+						"ANT":    "Antenna 1",
+						"CHAB":   "Channel A only selected",
+						"OVLSUR": "No overload",
+						"MSC":    "Monitoring system connected",
+						"SCF":    "Channel A in use",
+						"DLF":    "Channel A in use",
+						"OVLSCF": "No overload",
+						"OVLDLF": "No overload",
+					},
+				},
+				"I034/060": Fields{
+					"COM": Fields{"REDRDP": uint64(0), "REDXMT": uint64(0)},
+					"PSR": Fields{"POL": "Circular polarization", "REDRAD": uint64(0), "STC": "Map 1"},
+					"MDS": Fields{"REDRAD": uint64(0), "CLU": "Autonomous"},
+				},
+			},
+		},
+		{
+			Name:  "testcase 3: conditional UAP, plot",
+			input: "c0 00 0180",
+			uap:   genericUAP,
+			output: Fields{
+				"I250/020": Fields{"TYP": "plot"},
+				"I250/040": Fields{"RHO": 1.5},
+			},
+		},
+		{
+			Name:  "testcase 4: conditional UAP, track with repetitions",
+			input: "e0 80 0fa2 02 8102",
+			uap:   genericUAP,
+			output: Fields{
+				"I250/020": Fields{"TYP": "track"},
+				"I250/161": Fields{"TRN": uint64(0xfa2)},
+				"I250/030": []interface{}{Fields{"W": uint64(0x40)}, Fields{"W": uint64(0x01)}},
+			},
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(tc.input)
+		rec := goasterix.NewRecord()
+		_, err := rec.Decode(data, tc.uap)
+		if err != nil {
+			t.Fatalf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+
+		// Act
+		tree := Transform(*rec, tc.uap)
+
+		// Assert
+		if !reflect.DeepEqual(tree, tc.output) {
+			t.Errorf("FAIL: %s - tree = %v; Expected: %v", tc.Name, tree, tc.output)
+		} else {
+			t.Logf("SUCCESS: %s - tree = %v; Expected: %v", tc.Name, tree, tc.output)
+		}
+	}
+}
+
+func TestGenericModel_JSON(t *testing.T) {
+	// Arrange
+	input := "e0 80 0fa2 02 8102"
+	output := `{"category":250,"items":{"I250/020":{"TYP":"track"},"I250/030":[{"W":64},{"W":1}],"I250/161":{"TRN":4002}}}`
+	data, _ := util.HexStringToByte(input)
+	rec := goasterix.NewRecord()
+	_, _ = rec.Decode(data, genericUAP)
+	model := &GenericModel{Profiles: map[uint8]uap.StandardUAP{250: genericUAP}}
+
+	// Act
+	j, err := WriteModelJSON(model, *rec)
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: err = %v; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: err = %v; Expected: %v", err, nil)
+	}
+	if string(j) != output {
+		t.Errorf("FAIL: j = %s; Expected: %s", j, output)
+	} else {
+		t.Logf("SUCCESS: j = %s; Expected: %s", j, output)
+	}
+}
+
+func TestGenericModel_XML(t *testing.T) {
+	// Arrange
+	input := "e0 80 0fa2 02 8102"
+	output := `<GenericModel><category>250</category><items>` +
+		`<field name="I250/020"><field name="TYP">track</field></field>` +
+		`<field name="I250/030"><field name="W">64</field></field>` +
+		`<field name="I250/030"><field name="W">1</field></field>` +
+		`<field name="I250/161"><field name="TRN">4002</field></field>` +
+		`</items></GenericModel>`
+	data, _ := util.HexStringToByte(input)
+	rec := goasterix.NewRecord()
+	_, _ = rec.Decode(data, genericUAP)
+	model := &GenericModel{Profiles: map[uint8]uap.StandardUAP{250: genericUAP}}
+
+	// Act
+	x, err := WriteModelXML(model, *rec)
+
+	// Assert
+	if err != nil {
+		t.Errorf("FAIL: err = %v; Expected: %v", err, nil)
+	} else {
+		t.Logf("SUCCESS: err = %v; Expected: %v", err, nil)
+	}
+	if string(x) != output {
+		t.Errorf("FAIL: x = %s; Expected: %s", x, output)
+	} else {
+		t.Logf("SUCCESS: x = %s; Expected: %s", x, output)
+	}
+}
+
+func TestGenericModel_Decoder(t *testing.T) {
+	// Arrange
+	// the edition 1.0 describes I250/010 with SAC/SIC, the latest edition 2.0 with SID,
+	// the decoder selects the edition 1.0 for the source SAC/SIC = 0x08/0x36
+	ed1 := uap.StandardUAP{Name: "cat250_1.0", Category: 250, Version: 1.0, Edition: uap.Edition{Major: 1},
+		Items: []uap.DataField{{FRN: 1, DataItem: "I250/010", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2},
+			Subfields: []uap.Subfield{{Name: "SAC", Offset: 0, Width: 8}, {Name: "SIC", Offset: 8, Width: 8}}}}}
+	ed2 := uap.StandardUAP{Name: "cat250_2.0", Category: 250, Version: 2.0, Edition: uap.Edition{Major: 2},
+		Items: []uap.DataField{{FRN: 1, DataItem: "I250/010", Type: uap.Fixed, Fixed: uap.FixedField{Size: 2},
+			Subfields: []uap.Subfield{{Name: "SID", Offset: 0, Width: 16}}}}}
+	d := goasterix.NewDecoderFromRegistry(uap.NewRegistry(ed1, ed2))
+	errEdition := d.SetSourceEdition(0x08, 0x36, 250, uap.Edition{Major: 1})
+	data, _ := util.HexStringToByte("fa0006 80 0836")
+	db, _, err := d.Decode(data)
+	if errEdition != nil || err != nil {
+		t.Fatalf("FAIL: err = %v, %v; Expected: %v", errEdition, err, nil)
+	}
+	output := Fields{"I250/010": Fields{"SAC": uint64(8), "SIC": uint64(54)}}
+	outputLatest := Fields{"I250/010": Fields{"SID": uint64(0x0836)}}
+
+	// Act
+	model := &GenericModel{Decoder: d}
+	WriteModel(model, *db.Records[0])
+	latest := &GenericModel{Profiles: map[uint8]uap.StandardUAP{250: ed2}}
+	WriteModel(latest, *db.Records[0])
+
+	// Assert
+	if !reflect.DeepEqual(model.Items, output) {
+		t.Errorf("FAIL: items = %v; Expected: %v", model.Items, output)
+	} else {
+		t.Logf("SUCCESS: items = %v; Expected: %v", model.Items, output)
+	}
+	if !reflect.DeepEqual(latest.Items, outputLatest) {
+		t.Errorf("FAIL: items = %v; Expected: %v", latest.Items, outputLatest)
+	} else {
+		t.Logf("SUCCESS: items = %v; Expected: %v", latest.Items, outputLatest)
+	}
+}
+
+func TestTransform_DefaultProfiles(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name     string
+		category uint8
+		input    string
+	}
+	dataSet := []testCase{
+		{Name: "testcase 1: CAT048", category: 48, input: "ffd702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 063a 0743ce5b 40 20f5"},
+		{Name: "testcase 2: CAT034", category: 34, input: "f6083602429b7110940028200094008000"},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(tc.input)
+		rec := goasterix.NewRecord()
+		_, err := rec.Decode(data, uap.DefaultProfiles[tc.category])
+		if err != nil {
+			t.Fatalf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+		model := new(GenericModel)
+
+		// Act
+		WriteModel(model, *rec)
+
+		// Assert
+		if model.Category != tc.category || len(model.Items) != len(rec.Items) {
+			t.Errorf("FAIL: %s - category = %d, items = %d; Expected: %d, %d",
+				tc.Name, model.Category, len(model.Items), tc.category, len(rec.Items))
+		} else {
+			t.Logf("SUCCESS: %s - category = %d, items = %d; Expected: %d, %d",
+				tc.Name, model.Category, len(model.Items), tc.category, len(rec.Items))
+		}
+	}
+}
+
+func TestTransform_TypedModels(t *testing.T) {
+	// setup: the values of the typed models and of the sub-fields of the UAP must agree
+	type testCase struct {
+		Name   string
+		value  interface{}
+		output interface{}
+	}
+	data048, _ := util.HexStringToByte("ffd702 0836 429b52 a0 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 063a 0743ce5b 40 20f5")
+	rec048 := goasterix.NewRecord()
+	_, _ = rec048.Decode(data048, uap.Cat048V127)
+	model048 := new(Cat048Model)
+	model048.write(*rec048)
+	tree048 := Transform(*rec048, uap.Cat048V127)
+
+	data062, _ := util.HexStringToByte("bf5ffd0304 0900 01 532100 008e6f3e0017d096 1247f10b7086 fed3019a0fc8e301010c87304a04e072c34820e300820800eb003104b2190301487fa0ff0614ffffffffffff0493110101c006061414141400e0045b00e00182dc622931a410a800e00fc84010e001622b05010d01622902fea60177")
+	rec062 := goasterix.NewRecord()
+	_, _ = rec062.Decode(data062, uap.Cat062V119)
+	model062 := new(Cat062Model)
+	model062.write(*rec062)
+	tree062 := Transform(*rec062, uap.Cat062V119)
+
+	data030, _ := util.HexStringToByte("bfff0160 0885 5801b8 6092fc 010e 0200 0925f483 0c 04e6 04ea fb5ff9c4 f8 fd9a 0d0174 48455b 2cc371cf1de0")
+	rec030 := goasterix.NewRecord()
+	_, _ = rec030.Decode(data030, uap.Cat030StrV51)
+	model030 := new(Cat030STRModel)
+	model030.write(*rec030)
+	tree030 := Transform(*rec030, uap.Cat030StrV51)
+
+	data063, _ := util.HexStringToByte("bff0 090c 79387308290000000012000000000000000000000000")
+	rec063 := goasterix.NewRecord()
+	_, _ = rec063.Decode(data063, uap.Cat063V16)
+	model063 := new(Cat063Model)
+	model063.write(*rec063)
+	tree063 := Transform(*rec063, uap.Cat063V16)
+
+	field := func(tree Fields, item, name string) interface{} {
+		sub, _ := tree[item].(Fields)
+		return sub[name]
+	}
+	dataSet := []testCase{
+		{Name: "CAT048 SAC", value: uint64(model048.SacSic.Sac), output: field(tree048, "I048/010", "SAC")},
+		{Name: "CAT048 SIC", value: uint64(model048.SacSic.Sic), output: field(tree048, "I048/010", "SIC")},
+		{Name: "CAT048 TOD", value: model048.TimeOfDay, output: field(tree048, "I048/140", "TOD")},
+		{Name: "CAT048 RHO", value: model048.RhoTheta.Rho, output: field(tree048, "I048/040", "RHO")},
+		{Name: "CAT048 THETA", value: model048.RhoTheta.Theta, output: field(tree048, "I048/040", "THETA")},
+		{Name: "CAT048 MODE3A", value: model048.Mode3ACode.Squawk, output: strconv.FormatUint(field(tree048, "I048/070", "MODE3A").(uint64), 8)},
+		{Name: "CAT048 FL", value: model048.FlightLevel.Level, output: field(tree048, "I048/090", "FL")},
+		{Name: "CAT048 TRN", value: uint64(model048.TrackNumber), output: field(tree048, "I048/161", "TRN")},
+		{Name: "CAT048 GSP", value: model048.TrackVelocity.GroundSpeed, output: field(tree048, "I048/200", "GSP")},
+		{Name: "CAT048 HDG", value: model048.TrackVelocity.Heading, output: field(tree048, "I048/200", "HDG")},
+		{Name: "CAT062 SAC", value: uint64(model062.SacSic.Sac), output: field(tree062, "I062/010", "SAC")},
+		{Name: "CAT062 SIC", value: uint64(model062.SacSic.Sic), output: field(tree062, "I062/010", "SIC")},
+		{Name: "CAT062 SID", value: uint64(model062.ServiceIdentification), output: field(tree062, "I062/015", "SID")},
+		{Name: "CAT062 TOT", value: model062.TimeOfDay, output: field(tree062, "I062/070", "TOT")},
+		{Name: "CAT062 LAT", value: model062.TrackPositionWGS84.Latitude, output: field(tree062, "I062/105", "LAT")},
+		{Name: "CAT062 LON", value: model062.TrackPositionWGS84.Longitude, output: field(tree062, "I062/105", "LON")},
+		{Name: "CAT062 X", value: model062.CartesianXY.X, output: field(tree062, "I062/100", "X")},
+		{Name: "CAT062 Y", value: model062.CartesianXY.Y, output: field(tree062, "I062/100", "Y")},
+		{Name: "CAT062 VX", value: float64(model062.TrackVelocity.Vx), output: field(tree062, "I062/185", "VX")},
+		{Name: "CAT062 VY", value: float64(model062.TrackVelocity.Vy), output: field(tree062, "I062/185", "VY")},
+		{Name: "CAT062 MODE3A", value: model062.Mode3ACode.Squawk, output: strconv.FormatUint(field(tree062, "I062/060", "MODE3A").(uint64), 8)},
+		{Name: "CAT062 TRK", value: uint64(model062.TrackNumber), output: field(tree062, "I062/040", "TRK")},
+		{Name: "CAT062 MFL", value: float64(model062.FlightLevel), output: field(tree062, "I062/136", "MFL")},
+		{Name: "CAT062 ALT", value: float64(model062.GeometricAltitude), output: field(tree062, "I062/130", "ALT")},
+		{Name: "CAT062 CTB", value: model062.BarometricAltitude.Altitude, output: field(tree062, "I062/135", "CTB")},
+		{Name: "CAT062 ROCD", value: float64(model062.RateOfClimbDescent), output: field(tree062, "I062/220", "ROCD")},
+		{Name: "CAT063 TOM", value: model063.TimeOfMessage, output: field(tree063, "I063/030", "TOM")},
+		{Name: "CAT063 TSB", value: float64(model063.TimeStampingBias), output: field(tree063, "I063/070", "TSB")},
+		{Name: "CAT063 SRG", value: model063.ModeSRangeGainAndBias.SRG, output: field(tree063, "I063/080", "SRG")},
+		{Name: "CAT063 SRB", value: model063.ModeSRangeGainAndBias.SRB, output: field(tree063, "I063/080", "SRB")},
+		{Name: "CAT030 STR NUMERO", value: uint64(model030.Num.Numero), output: field(tree030, "I030/050", "NUMERO")},
+		{Name: "CAT030 STR HPTU", value: model030.Hptu, output: field(tree030, "I030/020", "HPTU")},
+		{Name: "CAT030 STR MODE3A", value: strconv.FormatUint(uint64(model030.Alis.Code), 10), output: strconv.FormatUint(field(tree030, "I030/060", "MODE3A").(uint64), 8)},
+		{Name: "CAT030 STR X", value: model030.Pos.X, output: field(tree030, "I030/100", "X")},
+		{Name: "CAT030 STR Y", value: model030.Pos.Y, output: field(tree030, "I030/100", "Y")},
+		{Name: "CAT030 STR QUAL", value: uint64(model030.Qual), output: field(tree030, "I030/090", "QUAL")},
+		{Name: "CAT030 STR FLPC", value: model030.Flpc.NiveauVol, output: field(tree030, "I030/135", "FL")},
+		{Name: "CAT030 STR FLPM", value: model030.Flpm.NiveauVol, output: field(tree030, "I030/136", "FL")},
+		{Name: "CAT030 STR VIT X", value: model030.Vit.X, output: field(tree030, "I030/181", "X")},
+		{Name: "CAT030 STR VIT Y", value: model030.Vit.Y, output: field(tree030, "I030/181", "Y")},
+		{Name: "CAT030 STR TAUX", value: model030.Taux, output: field(tree030, "I030/220", "TAUX")},
+		{Name: "CAT030 STR SY", value: uint64(model030.Spe.SY), output: field(tree030, "I030/SPE", "SY")},
+		{Name: "CAT030 STR O7", value: uint64(model030.Spe.O7), output: field(tree030, "I030/SPE", "O7")},
+		{Name: "CAT030 STR O10", value: uint64(model030.Spe.O10), output: field(tree030, "I030/SPE", "O10")},
+	}
+
+	for _, tc := range dataSet {
+		// Act & Assert
+		if !reflect.DeepEqual(tc.value, tc.output) {
+			t.Errorf("FAIL: %s - value = %v; Expected: %v", tc.Name, tc.value, tc.output)
+		} else {
+			t.Logf("SUCCESS: %s - value = %v; Expected: %v", tc.Name, tc.value, tc.output)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package transform
+
+import (
+	"encoding/json"
+
+	"github.com/mokhtarimokhtar/goasterix"
+)
+
+// Reader is implemented by the models which can be encoded back into an ASTERIX Record,
+// it is the reverse of Writer.
+type Reader interface {
+	read() (*goasterix.Record, error)
+}
+
+// ReadModel returns the ASTERIX Record encoded from the model r.
+func ReadModel(r Reader) (*goasterix.Record, error) {
+	return r.read()
+}
+
+// ReadModelJSON unmarshals the JSON j into the model r and returns the ASTERIX Record encoded from it.
+func ReadModelJSON(r Reader, j []byte) (*goasterix.Record, error) {
+	err := json.Unmarshal(j, r)
+	if err != nil {
+		return nil, err
+	}
+	return r.read()
+}
@@ -0,0 +1,243 @@
+package transform
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix"
+	"github.com/mokhtarimokhtar/goasterix/commbds"
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestReadModel(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        string
+		stdUAP       uap.StandardUAP
+		model        interface {
+			Reader
+			Writer
+		}
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT048 all items encodable",
+			input:        "dfff02 0836 429b52 94c70181 0913 02d0 6002b7 490d01 38a178cf4220 01e79a5d27a00c0010 063a 00800080 0743ce5b 40 20f5",
+			stdUAP:       uap.Cat048V127,
+			model:        new(Cat048Model),
+		},
+		{
+			TestCaseName: "CAT048 track status with first extent",
+			input:        "8102 0836 4180",
+			stdUAP:       uap.Cat048V127,
+			model:        new(Cat048Model),
+		},
+		{
+			TestCaseName: "CAT048 time of day at midnight and track number 0",
+			input:        "c110 0836 000000 0000",
+			stdUAP:       uap.Cat048V127,
+			model:        new(Cat048Model),
+		},
+		{
+			TestCaseName: "CAT062 flight level 0",
+			input:        "810120 0836 0000",
+			stdUAP:       uap.Cat062V119,
+			model:        new(Cat062Model),
+		},
+		{
+			TestCaseName: "CAT062 all items encodable",
+			input:        "bffdbc 0836 01 532100 008e6f3e0017d096 fff0000001a0 fed3019a 0102 0fc8 0004e072c34820 c101010c87304a04e072c3482000eb0031 04b2 1902 04 00e0 045b 00e0 0182",
+			stdUAP:       uap.Cat062V119,
+			model:        new(Cat062Model),
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(row.input)
+		rec := goasterix.NewRecord()
+		_, err := rec.Decode(data, row.stdUAP)
+		if err != nil {
+			t.Fatalf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, nil)
+		}
+		WriteModel(row.model, *rec)
+
+		// Act
+		res, err := ReadModel(row.model)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, nil)
+			continue
+		}
+		if reflect.DeepEqual(res.Payload(), data) == false {
+			t.Errorf("FAIL: %s %x; \nExpected: %x", row.TestCaseName, res.Payload(), data)
+		} else {
+			t.Logf("SUCCESS: %x; Expected: %x", res.Payload(), data)
+		}
+	}
+}
+
+func TestReadModelJSON(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        []byte
+		model        interface {
+			Reader
+			Writer
+		}
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT048",
+			input:        []byte(`{"sourceIdentifier":{"sac":8,"sic":54},"aircraftAddress":"490D01","aircraftIdentification":"NJE834H ","timeOfDay":34102.640625,"rhoTheta":{"rho":148.77734375,"theta":2.1148681640625},"cartesianXY":{"x":1,"y":1},"flightLevel":{"v":"code_validated","g":"default","level":180},"radarPlotCharacteristics":{"srr":2,"sam":-73},"mode3ACode":{"squawk":"4423","v":"code_validated","g":"default","l":"code_derived_from_transponder"},"trackNumber":1594,"trackVelocity":{"groundSpeed":0.11346435546875,"heading":290.1873779296875},"trackStatus":{"cnf":"confirmed_track","rad":"ssr_modes_track","dou":"normal_confidence","mah":"no_horizontal_man_sensed","cdm":"maintaining"},"comAcasCapabilityFlightStatus":{"com":"comm_a_and_comm_b_capability","stat":"no_alert_no_spi_aircraft_airborne","si":"si_code_capable","mssc":"yes","arc":"25_ft_resolution","aic":"yes","b1a":"1","b1b":"5"}}`),
+			model:        new(Cat048Model),
+		},
+		{
+			TestCaseName: "CAT062",
+			input:        []byte(`{"sourceIdentifier":{"sac":9,"sic":0},"serviceIdentification":1,"timeOfDay":42562,"trackPositionWGS84":{"latitude":50.07464289665222,"longitude":8.372386693954468},"cartesianXY":{"x":599032.5,"y":374851},"trackVelocity":{"vx":-75.25,"vy":102.5},"mode3ACode":{"v":"code_validated","g":"default","ch":"no_change","squawk":"7710"},"aircraftDerivedData":{"targetAddress":"87304A","targetIdentification":"ANA204  ","magneticHeading":319.616,"stateSelectedAltitude":{"mv":"manage_vertical_mode_active","ah":"altitude_hold_not_active","am":"approach_mode_not_active","altitude":13000},"machNumber":0.392,"indicatedAirSpeed":235},"trackNumber":1202,"trackStatus":{"mon":"monosensor","spi":"default_value","mrh":"barometric_altitude_reliable","src":"default_height","cnf":"confirmed_track","sim":"actual_track","tse":"default_value","tsb":"default_value","fpc":"not_flight_plan_correlated","aff":"default_value","stp":"default_value","kos":"background_service_used","ama":"track_not_resulting_amalgamation_process","md4":"no_mode_4_interrogation","me":"default_value","mi":"default_value","md5":"no_mode_5_interrogation","cst":"default_value","psr":"age_last_psr_track_higher_than_system_dependent_threshold","ssr":"default_value","mds":"default_value","ads":"age_last_ads_b_track_higher_than_system_dependent_threshold","suc":"default_value","aac":"default_value"},"modeOfmovement":{"trans":"constant_course","long":"constant_groundspeed","vert":"climb","adf":"no_altitude_discrepancy"},"flightLevel":56,"geometricAltitude":6968.75,"barometricAltitude":{"qnh":"no_qnh_correction_applied","altitude":56},"rateOfClimbDescent":2412.5}`),
+			model:        new(Cat062Model),
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		model := reflect.New(reflect.TypeOf(row.model).Elem()).Interface().(Writer)
+
+		// Act
+		rec, err := ReadModelJSON(row.model, row.input)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, nil)
+			continue
+		}
+		recJson, _ := WriteModelJSON(model, *rec)
+		if reflect.DeepEqual(recJson, row.input) == false {
+			t.Errorf("FAIL: %s %s; \nExpected: %s", row.TestCaseName, recJson, row.input)
+		} else {
+			t.Logf("SUCCESS: %s; Expected: %s", recJson, row.input)
+		}
+	}
+}
+
+func TestReadModel_Present(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        Reader
+		output       string
+	}
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT048 time of day 0 absent",
+			input:        &Cat048Model{SacSic: &SourceIdentifier{Sac: 8, Sic: 54}},
+			output:       "80 0836",
+		},
+		{
+			TestCaseName: "CAT048 time of day 0 present",
+			input:        &Cat048Model{SacSic: &SourceIdentifier{Sac: 8, Sic: 54}, Present: Presence{2: true}},
+			output:       "c0 0836 000000",
+		},
+		{
+			TestCaseName: "CAT048 negative flight level",
+			input:        &Cat048Model{FlightLevel: &FL{Level: -1}},
+			output:       "04 3ffc",
+		},
+		{
+			TestCaseName: "CAT062 track number 0 present",
+			input:        &Cat062Model{SacSic: &SourceIdentifier{Sac: 8, Sic: 54}, Present: Presence{12: true}},
+			output:       "8108 0836 0000",
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		output, _ := util.HexStringToByte(row.output)
+
+		// Act
+		res, err := ReadModel(row.input)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, nil)
+			continue
+		}
+		if reflect.DeepEqual(res.Payload(), output) == false {
+			t.Errorf("FAIL: %s %x; Expected: %x", row.TestCaseName, res.Payload(), output)
+		} else {
+			t.Logf("SUCCESS: %s %x; Expected: %x", row.TestCaseName, res.Payload(), output)
+		}
+	}
+}
+
+func TestReadModel_Error(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		input        Reader
+		err          error
+	}
+	notValid := "Not valid"
+	dataSet := []dataTest{
+		{
+			TestCaseName: "CAT048 unknown enumerated value",
+			input:        &Cat048Model{Mode3ACode: &Mode3A{Squawk: "7700", V: "unknown"}},
+			err:          ErrValueUnknown,
+		},
+		{
+			TestCaseName: "CAT048 squawk not octal",
+			input:        &Cat048Model{Mode3ACode: &Mode3A{Squawk: "7800"}},
+			err:          ErrValueUnknown,
+		},
+		{
+			TestCaseName: "CAT048 rho out of range",
+			input:        &Cat048Model{RhoTheta: &PolarPosition{Rho: 256, Theta: 0}},
+			err:          ErrValueOutOfRange,
+		},
+		{
+			TestCaseName: "CAT048 negative time of day",
+			input:        &Cat048Model{TimeOfDay: -1},
+			err:          ErrValueOutOfRange,
+		},
+		{
+			TestCaseName: "CAT048 identification unknown char",
+			input:        &Cat048Model{AircraftIdentification: "abc"},
+			err:          ErrCharUnknown,
+		},
+		{
+			TestCaseName: "CAT048 BDS decoded",
+			input: &Cat048Model{BDSRegisterData: []*commbds.Bds{
+				{TransponderRegisterNumber: "0", Code00: &notValid},
+				{TransponderRegisterNumber: "40"},
+			}},
+			err: ErrValueUnknown,
+		},
+		{
+			TestCaseName: "CAT062 latitude out of range",
+			input:        &Cat062Model{TrackPositionWGS84: &PositionWGS84{Latitude: 20000}},
+			err:          ErrValueOutOfRange,
+		},
+		{
+			TestCaseName: "CAT062 unknown track status",
+			input:        &Cat062Model{TrackStatus: &TrackStatus{MON: "monosensor", TrackStatusFifthExtent: TrackStatusFifthExtent{IEC: "yes"}}},
+			err:          ErrValueUnknown,
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		// Act
+		_, err := ReadModel(row.input)
+
+		// Assert
+		if err != row.err {
+			t.Errorf("FAIL: %s error = %v; Expected: %v", row.TestCaseName, err, row.err)
+		} else {
+			t.Logf("SUCCESS: error: %v; Expected: %v", err, row.err)
+		}
+	}
+}
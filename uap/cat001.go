@@ -4,6 +4,7 @@ var Cat001V12 = StandardUAP{
 	Name:     "cat001_1.2",
 	Category: 1,
 	Version:  1.2,
+	Edition:  Edition{Major: 1, Minor: 2},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -13,17 +14,50 @@ var Cat001V12 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         2,
 			DataItem:    "I001/020",
 			Description: "Target Report Descriptor",
-			Conditional: true,
 			Type:        Extended,
 			Extended: ExtendedField{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "TYP", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Plot"}, {Value: 1, Meaning: "Track"}}},
+				{Name: "SIM", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Actual plot or track"}, {Value: 1, Meaning: "Simulated plot or track"}}},
+				{Name: "SSRPSR", Offset: 2, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "No detection"},
+					{Value: 1, Meaning: "Sole primary detection"},
+					{Value: 2, Meaning: "Sole secondary detection"},
+					{Value: 3, Meaning: "Combined primary and secondary detection"},
+				}},
+				{Name: "ANT", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Target report from antenna 1"}, {Value: 1, Meaning: "Target report from antenna 2"}}},
+				{Name: "SPI", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Special Position Identification"}}},
+				{Name: "RAB", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Plot or track from a fixed transponder"}}},
+				{Name: "TST", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Test target indicator"}}},
+				{Name: "DS1DS2", Offset: 9, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Default"},
+					{Value: 1, Meaning: "Unlawful interference (code 7500)"},
+					{Value: 2, Meaning: "Radio-communication failure (code 7600)"},
+					{Value: 3, Meaning: "Emergency (code 7700)"},
+				}},
+				{Name: "ME", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Military emergency"}}},
+				{Name: "MI", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Military identification"}}},
+			},
+		},
+	},
+	Condition: &Condition{
+		FRN:  2,
+		Mask: 0x80,
+		Variants: []Variant{
+			{Value: 0x00, Items: Cat001PlotV12},
+			{Value: 0x80, Items: Cat001TrackV12},
 		},
 	},
 }
@@ -36,6 +70,10 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 4,
 		},
+		Subfields: []Subfield{
+			{Name: "RHO", Description: "Measured distance", Offset: 0, Width: 16, LSB: 1.0 / 128, Unit: "NM"},
+			{Name: "THETA", Description: "Measured azimuth", Offset: 16, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+		},
 	},
 	{
 		FRN:         4,
@@ -45,6 +83,15 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{
+				{Value: 0, Meaning: "Mode-3/A code derived from the reply of the transponder"},
+				{Value: 1, Meaning: "Smoothed Mode-3/A code as provided by a local tracker"},
+			}},
+			{Name: "MODE3A", Description: "Mode-3/A reply in octal representation", Offset: 4, Width: 12},
+		},
 	},
 	{
 		FRN:         5,
@@ -54,6 +101,11 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "FL", Description: "Flight Level", Offset: 2, Width: 14, Signed: true, LSB: 0.25, Unit: "FL"},
+		},
 	},
 	{
 		FRN:         6,
@@ -73,6 +125,9 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "TTOD", Description: "Truncated Time of Day", Offset: 0, Width: 16, LSB: 1.0 / 128, Unit: "s"},
+		},
 	},
 	{
 		FRN:         8,
@@ -82,6 +137,15 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{
+				{Value: 0, Meaning: "Mode-2 code derived from the reply of the transponder"},
+				{Value: 1, Meaning: "Smoothed Mode-2 code as provided by a local tracker"},
+			}},
+			{Name: "MODE2", Description: "Mode-2 code in octal representation", Offset: 4, Width: 12},
+		},
 	},
 	{
 		FRN:         9,
@@ -91,6 +155,9 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 1,
 		},
+		Subfields: []Subfield{
+			{Name: "DOP", Description: "Measured radial Doppler speed", Offset: 0, Width: 8, Signed: true, LSB: 1.0 / 16384, Unit: "NM/s"},
+		},
 	},
 	{
 		FRN:         10,
@@ -100,6 +167,9 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 1,
 		},
+		Subfields: []Subfield{
+			{Name: "POWER", Description: "Received power", Offset: 0, Width: 8, Signed: true, LSB: 1, Unit: "dBm"},
+		},
 	},
 	{
 		FRN:         11,
@@ -109,6 +179,20 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "QA4", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+			{Name: "QA2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+			{Name: "QA1", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+			{Name: "QB4", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+			{Name: "QB2", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+			{Name: "QB1", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+			{Name: "QC4", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+			{Name: "QC2", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+			{Name: "QC1", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+			{Name: "QD4", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+			{Name: "QD2", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+			{Name: "QD1", Offset: 15, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+		},
 	},
 	{
 		FRN:         12,
@@ -118,6 +202,23 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 4,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "MODEC", Description: "Mode-C reply in Gray notation", Offset: 4, Width: 12},
+			{Name: "QC1", Offset: 20, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+			{Name: "QA1", Offset: 21, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+			{Name: "QC2", Offset: 22, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+			{Name: "QA2", Offset: 23, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+			{Name: "QC4", Offset: 24, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+			{Name: "QA4", Offset: 25, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+			{Name: "QB1", Offset: 26, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+			{Name: "QD1", Offset: 27, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+			{Name: "QB2", Offset: 28, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+			{Name: "QD2", Offset: 29, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+			{Name: "QB4", Offset: 30, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+			{Name: "QD4", Offset: 31, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+		},
 	},
 	{
 		FRN:         13,
@@ -127,6 +228,20 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "QA4", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+			{Name: "QA2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+			{Name: "QA1", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+			{Name: "QB4", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+			{Name: "QB2", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+			{Name: "QB1", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+			{Name: "QC4", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+			{Name: "QC2", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+			{Name: "QC1", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+			{Name: "QD4", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+			{Name: "QD2", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+			{Name: "QD1", Offset: 15, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+		},
 	},
 	{
 		FRN:         14,
@@ -146,11 +261,11 @@ var Cat001PlotV12 = []DataField{
 		Fixed: FixedField{
 			Size: 1,
 		},
-	},
-	{
-		FRN:      16,
-		DataItem: "NA",
-		Type:     Spare,
+		Subfields: []Subfield{
+			{Name: "XA", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "X-pulse received in Mode-3/A reply"}}},
+			{Name: "XC", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "X-pulse received in Mode-C reply"}}},
+			{Name: "X2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "X-pulse received in Mode-2 reply"}}},
+		},
 	},
 	{
 		FRN:      16,
@@ -195,6 +310,9 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "TPN", Description: "Track/Plot Number", Offset: 0, Width: 16},
+		},
 	},
 	{
 		FRN:         4,
@@ -204,6 +322,10 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 4,
 		},
+		Subfields: []Subfield{
+			{Name: "RHO", Description: "Measured distance", Offset: 0, Width: 16, LSB: 1.0 / 128, Unit: "NM"},
+			{Name: "THETA", Description: "Measured azimuth", Offset: 16, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+		},
 	},
 	{
 		FRN:         5,
@@ -213,6 +335,10 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 4,
 		},
+		Subfields: []Subfield{
+			{Name: "X", Description: "X-Component", Offset: 0, Width: 16, Signed: true, LSB: 1.0 / 64, Unit: "NM"},
+			{Name: "Y", Description: "Y-Component", Offset: 16, Width: 16, Signed: true, LSB: 1.0 / 64, Unit: "NM"},
+		},
 	},
 	{
 		FRN:         6,
@@ -222,6 +348,10 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 4,
 		},
+		Subfields: []Subfield{
+			{Name: "GSP", Description: "Calculated groundspeed", Offset: 0, Width: 16, LSB: 1.0 / 16384, Unit: "NM/s"},
+			{Name: "HDG", Description: "Calculated heading", Offset: 16, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+		},
 	},
 	{
 		FRN:         7,
@@ -231,6 +361,15 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{
+				{Value: 0, Meaning: "Mode-3/A code derived from the reply of the transponder"},
+				{Value: 1, Meaning: "Smoothed Mode-3/A code as provided by a local tracker"},
+			}},
+			{Name: "MODE3A", Description: "Mode-3/A reply in octal representation", Offset: 4, Width: 12},
+		},
 	},
 	{
 		FRN:         8,
@@ -240,6 +379,11 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "FL", Description: "Flight Level", Offset: 2, Width: 14, Signed: true, LSB: 0.25, Unit: "FL"},
+		},
 	},
 	{
 		FRN:         9,
@@ -249,11 +393,15 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "TTOD", Description: "Truncated Time of Day", Offset: 0, Width: 16, LSB: 1.0 / 128, Unit: "s"},
+		},
 	},
 	{
 		FRN:         10,
 		DataItem:    "I001/130",
 		Description: "Radar Plot Characteristics",
+		Type:        Extended,
 		Extended: ExtendedField{
 			PrimarySize:   1,
 			SecondarySize: 1,
@@ -267,6 +415,9 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 1,
 		},
+		Subfields: []Subfield{
+			{Name: "POWER", Description: "Received power", Offset: 0, Width: 8, Signed: true, LSB: 1, Unit: "dBm"},
+		},
 	},
 	{
 		FRN:         12,
@@ -276,6 +427,9 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 1,
 		},
+		Subfields: []Subfield{
+			{Name: "DOP", Description: "Measured radial Doppler speed", Offset: 0, Width: 8, Signed: true, LSB: 1.0 / 16384, Unit: "NM/s"},
+		},
 	},
 	{
 		FRN:         13,
@@ -286,6 +440,15 @@ var Cat001TrackV12 = []DataField{
 			PrimarySize:   1,
 			SecondarySize: 1,
 		},
+		Subfields: []Subfield{
+			{Name: "CON", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Confirmed track"}, {Value: 1, Meaning: "Track in initialisation phase"}}},
+			{Name: "RAD", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Primary track"}, {Value: 1, Meaning: "SSR/Combined track"}}},
+			{Name: "MAN", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Aircraft manoeuvring"}}},
+			{Name: "DOU", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Doubtful plot to track association"}}},
+			{Name: "RDPC", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "RDP Chain 1"}, {Value: 1, Meaning: "RDP Chain 2"}}},
+			{Name: "GHO", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Ghost track"}}},
+			{Name: "TRE", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Last report for a track"}}},
+		},
 	},
 	{
 		FRN:         14,
@@ -305,6 +468,15 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{
+				{Value: 0, Meaning: "Mode-2 code derived from the reply of the transponder"},
+				{Value: 1, Meaning: "Smoothed Mode-2 code as provided by a local tracker"},
+			}},
+			{Name: "MODE2", Description: "Mode-2 code in octal representation", Offset: 4, Width: 12},
+		},
 	},
 	{
 		FRN:         16,
@@ -314,6 +486,20 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "QA4", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+			{Name: "QA2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+			{Name: "QA1", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+			{Name: "QB4", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+			{Name: "QB2", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+			{Name: "QB1", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+			{Name: "QC4", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+			{Name: "QC2", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+			{Name: "QC1", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+			{Name: "QD4", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+			{Name: "QD2", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+			{Name: "QD1", Offset: 15, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+		},
 	},
 	{
 		FRN:         17,
@@ -323,6 +509,23 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 4,
 		},
+		Subfields: []Subfield{
+			{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+			{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+			{Name: "MODEC", Description: "Mode-C reply in Gray notation", Offset: 4, Width: 12},
+			{Name: "QC1", Offset: 20, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+			{Name: "QA1", Offset: 21, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+			{Name: "QC2", Offset: 22, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+			{Name: "QA2", Offset: 23, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+			{Name: "QC4", Offset: 24, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+			{Name: "QA4", Offset: 25, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+			{Name: "QB1", Offset: 26, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+			{Name: "QD1", Offset: 27, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+			{Name: "QB2", Offset: 28, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+			{Name: "QD2", Offset: 29, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+			{Name: "QB4", Offset: 30, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+			{Name: "QD4", Offset: 31, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+		},
 	},
 	{
 		FRN:         18,
@@ -332,6 +535,20 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 2,
 		},
+		Subfields: []Subfield{
+			{Name: "QA4", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+			{Name: "QA2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+			{Name: "QA1", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+			{Name: "QB4", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+			{Name: "QB2", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+			{Name: "QB1", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+			{Name: "QC4", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+			{Name: "QC2", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+			{Name: "QC1", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+			{Name: "QD4", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+			{Name: "QD2", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+			{Name: "QD1", Offset: 15, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+		},
 	},
 	{
 		FRN:         19,
@@ -363,5 +580,10 @@ var Cat001TrackV12 = []DataField{
 		Fixed: FixedField{
 			Size: 1,
 		},
+		Subfields: []Subfield{
+			{Name: "XA", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "X-pulse received in Mode-3/A reply"}}},
+			{Name: "XC", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "X-pulse received in Mode-C reply"}}},
+			{Name: "X2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "X-pulse received in Mode-2 reply"}}},
+		},
 	},
 }
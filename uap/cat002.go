@@ -5,6 +5,7 @@ package uap
 var Cat002V10 = StandardUAP{
 	Category: 2,
 	Version:  1.0,
+	Edition:  Edition{Major: 1, Minor: 0},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -14,6 +15,10 @@ var Cat002V10 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         2,
@@ -23,6 +28,15 @@ var Cat002V10 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "MT", Description: "Message Type", Offset: 0, Width: 8, Values: []ValueTable{
+					{Value: 1, Meaning: "North marker message"},
+					{Value: 2, Meaning: "Sector crossing message"},
+					{Value: 3, Meaning: "South marker message"},
+					{Value: 8, Meaning: "Activation of blind zone filtering"},
+					{Value: 9, Meaning: "Stop of blind zone filtering"},
+				}},
+			},
 		},
 		{
 			FRN:         3,
@@ -32,6 +46,9 @@ var Cat002V10 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "SN", Description: "Sector Number", Offset: 0, Width: 8, LSB: 360.0 / 256, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         4,
@@ -41,6 +58,9 @@ var Cat002V10 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TOD", Description: "Time of Day", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         5,
@@ -50,6 +70,9 @@ var Cat002V10 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "ARP", Description: "Antenna Rotation Period", Offset: 0, Width: 16, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         6,
@@ -88,6 +111,12 @@ var Cat002V10 = StandardUAP{
 			Fixed: FixedField{
 				Size: 8,
 			},
+			Subfields: []Subfield{
+				{Name: "RHOST", Description: "Rho start", Offset: 0, Width: 16, LSB: 1.0 / 128, Unit: "NM"},
+				{Name: "RHOEND", Description: "Rho end", Offset: 16, Width: 16, LSB: 1.0 / 128, Unit: "NM"},
+				{Name: "THETAST", Description: "Theta start", Offset: 32, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+				{Name: "THETAEND", Description: "Theta end", Offset: 48, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         10,
@@ -97,6 +126,10 @@ var Cat002V10 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "RE", Description: "Range error", Offset: 0, Width: 8, Signed: true, LSB: 1.0 / 128, Unit: "NM"},
+				{Name: "AE", Description: "Azimuth error", Offset: 8, Width: 8, Signed: true, LSB: 360.0 / 16384, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         11,
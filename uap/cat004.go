@@ -6,6 +6,7 @@ var Cat004V112 = StandardUAP{
 	Name:     "cat004_1.12",
 	Category: 4,
 	Version:  1.12,
+	Edition:  Edition{Major: 1, Minor: 12},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -15,6 +16,10 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         2,
@@ -24,6 +29,57 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "MT", Description: "Message Type", Offset: 0, Width: 8, Values: []ValueTable{
+					{Value: 1, Meaning: "Alive Message (AM)"},
+					{Value: 2, Meaning: "Route Adherence Monitor Longitudinal Deviation (RAMLD)"},
+					{Value: 3, Meaning: "Route Adherence Monitor Heading Deviation (RAMHD)"},
+					{Value: 4, Meaning: "Minimum Safe Altitude Warning (MSAW)"},
+					{Value: 5, Meaning: "Area Proximity Warning (APW)"},
+					{Value: 6, Meaning: "Clearance Level Adherence Monitor (CLAM)"},
+					{Value: 7, Meaning: "Short Term Conflict Alert (STCA)"},
+					{Value: 8, Meaning: "Approach Path Monitor (APM)"},
+					{Value: 9, Meaning: "RIMCAS Arrival / Landing Monitor (ALM)"},
+					{Value: 10, Meaning: "RIMCAS Arrival / Departure Wrong Runway Alert (WRA)"},
+					{Value: 11, Meaning: "RIMCAS Arrival / Departure Opposite Traffic Alert (OTA)"},
+					{Value: 12, Meaning: "RIMCAS Departure Monitor (RDM)"},
+					{Value: 13, Meaning: "RIMCAS Runway / Taxiway Crossing Monitor (RCM)"},
+					{Value: 14, Meaning: "RIMCAS Taxiway Separation Monitor (TSM)"},
+					{Value: 15, Meaning: "RIMCAS Unauthorized Taxiway Movement Monitor (UTMM)"},
+					{Value: 16, Meaning: "RIMCAS Stop Bar Overrun Alert (SBOA)"},
+					{Value: 17, Meaning: "End Of Conflict (EOC)"},
+					{Value: 18, Meaning: "ACAS Resolution Advisory (ACASRA)"},
+					{Value: 19, Meaning: "Near Term Conflict Alert (NTCA)"},
+					{Value: 20, Meaning: "Downlinked Barometric Pressure Setting Monitor (DBPSM)"},
+					{Value: 21, Meaning: "Speed Adherence Monitor (SAM)"},
+					{Value: 22, Meaning: "Outside Controlled Airspace Tool (OCAT)"},
+					{Value: 23, Meaning: "Vertical Conflict Detection (VCD)"},
+					{Value: 24, Meaning: "Vertical Rate Adherence Monitor (VRAM)"},
+					{Value: 25, Meaning: "Cleared Heading Adherence Monitor (CHAM)"},
+					{Value: 26, Meaning: "Downlinked Selected Altitude Monitor (DSAM)"},
+					{Value: 27, Meaning: "Holding Adherence Monitor (HAM)"},
+					{Value: 28, Meaning: "Vertical Path Monitor (VPM)"},
+					{Value: 29, Meaning: "RIMCAS Taxiway Traffic Alert (TTA)"},
+					{Value: 30, Meaning: "RIMCAS Arrival / Departure Close Runway Alert (CRA)"},
+					{Value: 31, Meaning: "RIMCAS Arrival / Departure Aircraft Separation Monitor (ASM)"},
+					{Value: 32, Meaning: "RIMCAS ILS Area Violation Monitor (IAVM)"},
+					{Value: 33, Meaning: "Final Target Distance Indicator (FTD)"},
+					{Value: 34, Meaning: "Initial Target Distance Indicator (ITD)"},
+					{Value: 35, Meaning: "Wake Vortex Indicator Infringement Alert (IIA)"},
+					{Value: 36, Meaning: "Sequence Warning (SQW)"},
+					{Value: 37, Meaning: "Catch Up Warning (CUW)"},
+					{Value: 38, Meaning: "Conflicting ATC Clearances (CATC)"},
+					{Value: 39, Meaning: "No ATC Clearance (NOCLR)"},
+					{Value: 40, Meaning: "Aircraft Not Moving despite ATC Clearance (NOMOV)"},
+					{Value: 41, Meaning: "Aircraft leaving / entering the aerodrome area without proper handover (NOH)"},
+					{Value: 42, Meaning: "Wrong Runway or Taxiway Type (WRTY)"},
+					{Value: 43, Meaning: "Stand Occupied (STOCC)"},
+					{Value: 44, Meaning: "Ongoing Alert (ONGOING)"},
+					{Value: 97, Meaning: "Lost Track Warning (LTW)"},
+					{Value: 98, Meaning: "Holding Volume Infringement (HVI)"},
+					{Value: 99, Meaning: "Airspace Infringement Warning (AIW)"},
+				}},
+			},
 		},
 		{
 			FRN:         3,
@@ -33,6 +89,10 @@ var Cat004V112 = StandardUAP{
 			Repetitive: RepetitiveField{
 				SubItemSize: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         4,
@@ -42,6 +102,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TOM", Description: "Time of Message", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         5,
@@ -51,6 +114,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "AI", Description: "Alert Identifier", Offset: 0, Width: 16},
+			},
 		},
 		{
 			FRN:         6,
@@ -60,6 +126,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "STAT", Description: "Status of the alert", Offset: 4, Width: 3},
+			},
 		},
 		{
 			FRN:         7,
@@ -80,6 +149,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "TRK1", Description: "Track Number 1", Offset: 0, Width: 16},
+			},
 		},
 		{
 			FRN:         9,
@@ -104,6 +176,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "MODE3A", Description: "Mode-3/A code in octal representation", Offset: 4, Width: 12},
+					},
 				},
 				{
 					FRN:         3,
@@ -113,6 +188,11 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 10,
 					},
+					Subfields: []Subfield{
+						{Name: "LAT", Description: "Latitude in WGS-84", Offset: 0, Width: 32, Signed: true, LSB: 180.0 / (1 << 25), Unit: "deg"},
+						{Name: "LON", Description: "Longitude in WGS-84", Offset: 32, Width: 32, Signed: true, LSB: 180.0 / (1 << 25), Unit: "deg"},
+						{Name: "ALT", Description: "Altitude of predicted conflict", Offset: 64, Width: 16, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         4,
@@ -122,6 +202,11 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 8,
 					},
+					Subfields: []Subfield{
+						{Name: "X", Description: "X-Component", Offset: 0, Width: 24, Signed: true, LSB: 0.5, Unit: "m"},
+						{Name: "Y", Description: "Y-Component", Offset: 24, Width: 24, Signed: true, LSB: 0.5, Unit: "m"},
+						{Name: "Z", Description: "Z-Component", Offset: 48, Width: 16, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         5,
@@ -131,6 +216,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 3,
 					},
+					Subfields: []Subfield{
+						{Name: "TT", Description: "Time to runway threshold", Offset: 0, Width: 24, Signed: true, LSB: 1.0 / 128, Unit: "s"},
+					},
 				},
 				{
 					FRN:         6,
@@ -140,6 +228,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "DT", Description: "Distance to runway threshold", Offset: 0, Width: 16, LSB: 0.5, Unit: "m"},
+					},
 				},
 				{
 					FRN:         7,
@@ -150,6 +241,35 @@ var Cat004V112 = StandardUAP{
 						PrimarySize:   1,
 						SecondarySize: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "GATOAT", Offset: 0, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "General Air Traffic"},
+							{Value: 2, Meaning: "Operational Air Traffic"},
+							{Value: 3, Meaning: "Not applicable"},
+						}},
+						{Name: "FR1FR2", Offset: 2, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Instrument Flight Rules"},
+							{Value: 1, Meaning: "Visual Flight Rules"},
+							{Value: 2, Meaning: "Not applicable"},
+							{Value: 3, Meaning: "Controlled Visual Flight Rules"},
+						}},
+						{Name: "RVSM", Offset: 4, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "Approved"},
+							{Value: 2, Meaning: "Exempt"},
+							{Value: 3, Meaning: "Not Approved"},
+						}},
+						{Name: "HPR", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Normal Priority Flight"}, {Value: 1, Meaning: "High Priority Flight"}}},
+						{Name: "CDM", Offset: 8, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Maintaining"},
+							{Value: 1, Meaning: "Climbing"},
+							{Value: 2, Meaning: "Descending"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+						{Name: "PRI", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Non primary target"}, {Value: 1, Meaning: "Primary target"}}},
+						{Name: "GV", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Ground Vehicle"}}},
+					},
 				},
 				// FX
 				{
@@ -169,6 +289,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "NBR", Description: "Flight plan number", Offset: 5, Width: 27},
+					},
 				},
 				{
 					FRN:         10,
@@ -178,6 +301,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "CFL", Description: "Cleared Flight Level", Offset: 0, Width: 16, Signed: true, LSB: 0.25, Unit: "FL"},
+					},
 				},
 				{
 					FRN:  11,
@@ -213,6 +339,28 @@ var Cat004V112 = StandardUAP{
 						PrimarySize:   1,
 						SecondarySize: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MAS", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Conflict not predicted to occur in military airspace"}, {Value: 1, Meaning: "Conflict predicted to occur in military airspace"}}},
+						{Name: "CAS", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Conflict not predicted to occur in civil airspace"}, {Value: 1, Meaning: "Conflict predicted to occur in civil airspace"}}},
+						{Name: "FLD", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Aircraft are not fast diverging laterally at current time"}, {Value: 1, Meaning: "Aircraft are fast diverging laterally at current time"}}},
+						{Name: "FVD", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Aircraft are not fast diverging vertically at current time"}, {Value: 1, Meaning: "Aircraft are fast diverging vertically at current time"}}},
+						{Name: "TYPE", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Minor separation infringement"}, {Value: 1, Meaning: "Major separation infringement"}}},
+						{Name: "CROSS", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Aircraft have not crossed at starting time of conflict"}, {Value: 1, Meaning: "Aircraft have crossed at starting time of conflict"}}},
+						{Name: "DIV", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Aircraft are not diverging at starting time of conflict"}, {Value: 1, Meaning: "Aircraft are diverging at starting time of conflict"}}},
+						{Name: "RRC", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Runway/Runway Crossing"}}},
+						{Name: "RTC", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Runway/Taxiway Crossing"}}},
+						{Name: "MRVA", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 4 (MSAW) indicates MRVA"}}},
+						{Name: "VRAMCRM", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 25 (VRAM) indicates CRM"}}},
+						{Name: "VRAMVRM", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 25 (VRAM) indicates VRM"}}},
+						{Name: "VRAMVTM", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 25 (VRAM) indicates VTM"}}},
+						{Name: "HAMHD", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 29 (HAM) indicates HD"}}},
+						{Name: "HAMRD", Offset: 16, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 29 (HAM) indicates RD"}}},
+						{Name: "HAMVD", Offset: 17, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 29 (HAM) indicates VD"}}},
+						{Name: "DBPSMARR", Offset: 18, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 20 (DBPSM) indicates ARR"}}},
+						{Name: "DBPSMDEP", Offset: 19, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 20 (DBPSM) indicates DEP"}}},
+						{Name: "DBPSMTL", Offset: 20, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 20 (DBPSM) indicates above transition level"}}},
+						{Name: "AIW", Offset: 21, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Msg Type 99 (AIW) indicates pAIW Alert"}}},
+					},
 				},
 				{
 					FRN:         2,
@@ -222,6 +370,11 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TID", Description: "Identification of Conflict Categories Definition Table", Offset: 0, Width: 4},
+						{Name: "CPC", Description: "Conflict Properties Class", Offset: 4, Width: 3},
+						{Name: "CS", Description: "Conflict Severity", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Low"}, {Value: 1, Meaning: "High"}}},
+					},
 				},
 				{
 					FRN:         3,
@@ -231,6 +384,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "CP", Description: "Conflict Probability", Offset: 0, Width: 8, LSB: 0.5, Unit: "%"},
+					},
 				},
 				{
 					FRN:         4,
@@ -240,6 +396,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 3,
 					},
+					Subfields: []Subfield{
+						{Name: "CD", Description: "Conflict Duration", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+					},
 				},
 				{
 					FRN:  5,
@@ -270,6 +429,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 3,
 					},
+					Subfields: []Subfield{
+						{Name: "TC", Description: "Time to Conflict", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+					},
 				},
 				{
 					FRN:         2,
@@ -279,6 +441,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 3,
 					},
+					Subfields: []Subfield{
+						{Name: "TCA", Description: "Time to Closest Approach", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+					},
 				},
 				{
 					FRN:         3,
@@ -288,6 +453,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 3,
 					},
+					Subfields: []Subfield{
+						{Name: "CHS", Description: "Current Horizontal Separation", Offset: 0, Width: 24, LSB: 0.5, Unit: "m"},
+					},
 				},
 				{
 					FRN:         4,
@@ -297,6 +465,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "MHS", Description: "Estimated Minimum Horizontal Separation", Offset: 0, Width: 16, LSB: 0.5, Unit: "m"},
+					},
 				},
 				{
 					FRN:         5,
@@ -306,6 +477,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "CVS", Description: "Current Vertical Separation", Offset: 0, Width: 16, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         6,
@@ -315,6 +489,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "MVS", Description: "Estimated Minimum Vertical Separation", Offset: 0, Width: 16, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:  7,
@@ -331,6 +508,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "VD", Description: "Vertical Deviation", Offset: 0, Width: 16, Signed: true, LSB: 25, Unit: "ft"},
+			},
 		},
 		{
 			FRN:         13,
@@ -340,6 +520,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "LD", Description: "Longitudinal Deviation", Offset: 0, Width: 16, Signed: true, LSB: 32, Unit: "m"},
+			},
 		},
 		{
 			FRN:         14,
@@ -349,6 +532,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TDD", Description: "Transversal Distance Deviation", Offset: 0, Width: 24, Signed: true, LSB: 0.5, Unit: "m"},
+			},
 		},
 		// FX
 		{
@@ -426,6 +612,9 @@ var Cat004V112 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "TRK2", Description: "Track Number 2", Offset: 0, Width: 16},
+			},
 		},
 		{
 			FRN:         17,
@@ -450,6 +639,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "MODE3A", Description: "Mode-3/A code in octal representation", Offset: 4, Width: 12},
+					},
 				},
 				{
 					FRN:         3,
@@ -459,6 +651,11 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 10,
 					},
+					Subfields: []Subfield{
+						{Name: "LAT", Description: "Latitude in WGS-84", Offset: 0, Width: 32, Signed: true, LSB: 180.0 / (1 << 25), Unit: "deg"},
+						{Name: "LON", Description: "Longitude in WGS-84", Offset: 32, Width: 32, Signed: true, LSB: 180.0 / (1 << 25), Unit: "deg"},
+						{Name: "ALT", Description: "Altitude of predicted conflict", Offset: 64, Width: 16, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         4,
@@ -468,6 +665,11 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 8,
 					},
+					Subfields: []Subfield{
+						{Name: "X", Description: "X-Component", Offset: 0, Width: 24, Signed: true, LSB: 0.5, Unit: "m"},
+						{Name: "Y", Description: "Y-Component", Offset: 24, Width: 24, Signed: true, LSB: 0.5, Unit: "m"},
+						{Name: "Z", Description: "Z-Component", Offset: 48, Width: 16, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         5,
@@ -477,6 +679,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 3,
 					},
+					Subfields: []Subfield{
+						{Name: "TT", Description: "Time to runway threshold", Offset: 0, Width: 24, Signed: true, LSB: 1.0 / 128, Unit: "s"},
+					},
 				},
 				{
 					FRN:         6,
@@ -486,6 +691,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "DT", Description: "Distance to runway threshold", Offset: 0, Width: 16, LSB: 0.5, Unit: "m"},
+					},
 				},
 				{
 					FRN:         7,
@@ -496,6 +704,35 @@ var Cat004V112 = StandardUAP{
 						PrimarySize:   1,
 						SecondarySize: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "GATOAT", Offset: 0, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "General Air Traffic"},
+							{Value: 2, Meaning: "Operational Air Traffic"},
+							{Value: 3, Meaning: "Not applicable"},
+						}},
+						{Name: "FR1FR2", Offset: 2, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Instrument Flight Rules"},
+							{Value: 1, Meaning: "Visual Flight Rules"},
+							{Value: 2, Meaning: "Not applicable"},
+							{Value: 3, Meaning: "Controlled Visual Flight Rules"},
+						}},
+						{Name: "RVSM", Offset: 4, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "Approved"},
+							{Value: 2, Meaning: "Exempt"},
+							{Value: 3, Meaning: "Not Approved"},
+						}},
+						{Name: "HPR", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Normal Priority Flight"}, {Value: 1, Meaning: "High Priority Flight"}}},
+						{Name: "CDM", Offset: 8, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Maintaining"},
+							{Value: 1, Meaning: "Climbing"},
+							{Value: 2, Meaning: "Descending"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+						{Name: "PRI", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Non primary target"}, {Value: 1, Meaning: "Primary target"}}},
+						{Name: "GV", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Ground Vehicle"}}},
+					},
 				},
 				// FX
 				{
@@ -515,6 +752,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "NBR", Description: "Flight plan number", Offset: 5, Width: 27},
+					},
 				},
 				{
 					FRN:         10,
@@ -524,6 +764,9 @@ var Cat004V112 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "CFL", Description: "Cleared Flight Level", Offset: 0, Width: 16, Signed: true, LSB: 0.25, Unit: "FL"},
+					},
 				},
 				{
 					FRN:  11,
@@ -552,6 +795,10 @@ var Cat004V112 = StandardUAP{
 			Repetitive: RepetitiveField{
 				SubItemSize: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "CENTRE", Description: "8-bit Group Identification code", Offset: 0, Width: 8},
+				{Name: "POSITION", Description: "8-bit Control Position identification code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:      19,
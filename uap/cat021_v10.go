@@ -6,6 +6,7 @@ var Cat021v10 = StandardUAP{
 	Name:     "cat021_2.5",
 	Category: 21,
 	Version:  2.5,
+	Edition:  Edition{Major: 2, Minor: 5},
 	Items: []DataField{
 		{
 			FRN:      1,
@@ -351,17 +352,22 @@ var Cat021v10 = StandardUAP{
 			Type:        Compound,
 			Compound: []DataField{
 				{
-					FRN:  1,
-					Type: Fixed,
-					Fixed: FixedField{
-						Size: 1,
+					FRN:         1,
+					DataItem:    "TIS",
+					Description: "Trajectory Intent Status",
+					Type:        Extended,
+					Extended: ExtendedField{
+						PrimarySize:   1,
+						SecondarySize: 1,
 					},
 				},
 				{
-					FRN:  2,
-					Type: Fixed,
-					Fixed: FixedField{
-						Size: 15,
+					FRN:         2,
+					DataItem:    "TID",
+					Description: "Trajectory Intent Data",
+					Type:        Repetitive,
+					Repetitive: RepetitiveField{
+						SubItemSize: 15,
 					},
 				},
 				{
@@ -6,6 +6,7 @@ var Cat030ArtasV70 = StandardUAP{
 	Name:     "ARTAS",
 	Category: 30,
 	Version:  7.0,
+	Edition:  Edition{Major: 7, Minor: 0},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -501,6 +502,7 @@ var Cat030ArtasV62 = StandardUAP{
 	Name:     "ARTAS",
 	Category: 30,
 	Version:  6.2,
+	Edition:  Edition{Major: 6, Minor: 2},
 	Items: []DataField{
 		{
 			FRN:         1,
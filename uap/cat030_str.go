@@ -7,6 +7,7 @@ var Cat030StrV51 = StandardUAP{
 	Name:     "STR",
 	Category: 30,
 	Version:  5.1,
+	Edition:  Edition{Major: 5, Minor: 1},
 	Items: []DataField{
 		{
 			FRN:      1,
@@ -15,6 +16,10 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:      2,
@@ -28,6 +33,17 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "VERSION", Description: "Numéro de la version logicielle en service", Offset: 0, Width: 3},
+				{Name: "NAP", Description: "Numéro du calculateur", Offset: 3, Width: 2},
+				{Name: "ST", Description: "Statut du serveur", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Opérationnel"}, {Value: 1, Meaning: "Evaluation"}}},
+				{Name: "NS", Description: "Mode du serveur", Offset: 6, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Principal"},
+					{Value: 1, Meaning: "Secours"},
+					{Value: 2, Meaning: "Test"},
+				}},
+				{Name: "NUMERO", Description: "Numéro de piste", Offset: 11, Width: 12},
+			},
 		},
 		{
 			FRN:      4,
@@ -36,6 +52,9 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "HPTU", Description: "Heure TU de la piste", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:      5,
@@ -45,6 +64,46 @@ var Cat030StrV51 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "LIV", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Trafic réel"}, {Value: 1, Meaning: "Simulé ou plot test"}}},
+				{Name: "CNF", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Piste confirmée"}, {Value: 1, Meaning: "Piste en initialisation"}}},
+				{Name: "MAN", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Piste en virage"}}},
+				{Name: "TVA", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Piste sans niveau de vol valide"}}},
+				{Name: "TYPE", Offset: 4, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "Piste association multiple primaire et secondaire"},
+					{Value: 1, Meaning: "Piste association primaire pure"},
+					{Value: 2, Meaning: "Piste association multiple secondaire pure"},
+					{Value: 3, Meaning: "Piste monoradar P+S"},
+					{Value: 4, Meaning: "Piste monoradar secondaire pure"},
+					{Value: 5, Meaning: "Piste monoradar primaire pure"},
+					{Value: 7, Meaning: "Piste en manque"},
+				}},
+				{Name: "MORT", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Mort de piste"}}},
+				{Name: "CRE", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Création de piste"}}},
+				{Name: "SLR", Offset: 10, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Coordonnées projetées au niveau calculé"},
+					{Value: 1, Meaning: "Coordonnées projetées au niveau mesuré"},
+					{Value: 2, Meaning: "Coordonnées projetées au niveau forfaitaire"},
+					{Value: 3, Meaning: "Coordonnées rabattues"},
+				}},
+				{Name: "COR", Offset: 12, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "Piste corrélée plan de vol confirmée"},
+					{Value: 1, Meaning: "Piste corrélée plan de vol associée"},
+					{Value: 2, Meaning: "Piste corrélée plan de vol gelée"},
+					{Value: 3, Meaning: "Piste en post-corrélation"},
+					{Value: 7, Meaning: "Piste non corrélée plan de vol"},
+				}},
+				{Name: "DS1DS2", Offset: 16, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Défaut"},
+					{Value: 1, Meaning: "Détournement (code 7500)"},
+					{Value: 2, Meaning: "Panne radio (code 7600)"},
+					{Value: 3, Meaning: "Détresse (code 7700)"},
+				}},
+				{Name: "FOR", Offset: 18, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Vol en formation"}}},
+				{Name: "AMA", Offset: 19, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Piste amalgamée"}, {Value: 1, Meaning: "Piste non amalgamée"}}},
+				{Name: "SPI", Offset: 20, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Special Pulse Ident"}}},
+				{Name: "ME", Offset: 21, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Détresse militaire"}}},
+			},
 		},
 		{
 			FRN:      6,
@@ -53,6 +112,12 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code valide"}, {Value: 1, Meaning: "Code invalide"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Défaut"}, {Value: 1, Meaning: "Code garbling"}}},
+				{Name: "C", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Pas de changement de code"}, {Value: 1, Meaning: "Changement de code"}}},
+				{Name: "MODE3A", Description: "Mode A lissé en représentation octale", Offset: 4, Width: 12},
+			},
 		},
 		{
 			FRN:      7,
@@ -61,6 +126,10 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "X", Description: "Position X", Offset: 0, Width: 16, Signed: true, LSB: 1.0 / 64, Unit: "NM"},
+				{Name: "Y", Description: "Position Y", Offset: 16, Width: 16, Signed: true, LSB: 1.0 / 64, Unit: "NM"},
+			},
 		},
 		{
 			FRN:      8,
@@ -69,6 +138,9 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "QUAL", Description: "Qualité piste", Offset: 0, Width: 7},
+			},
 		},
 		{
 			FRN:      9,
@@ -77,6 +149,11 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+				{Name: "FL", Description: "Niveau de vol", Offset: 2, Width: 14, Signed: true, LSB: 0.25, Unit: "FL"},
+			},
 		},
 		{
 			FRN:      10,
@@ -85,6 +162,11 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+				{Name: "FL", Description: "Niveau de vol", Offset: 2, Width: 14, Signed: true, LSB: 0.25, Unit: "FL"},
+			},
 		},
 		{
 			FRN:      11,
@@ -93,6 +175,10 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "X", Description: "Vitesse X", Offset: 0, Width: 16, Signed: true, LSB: 1.0 / 16384, Unit: "NM/s"},
+				{Name: "Y", Description: "Vitesse Y", Offset: 16, Width: 16, Signed: true, LSB: 1.0 / 16384, Unit: "NM/s"},
+			},
 		},
 		{
 			FRN:      12,
@@ -101,6 +187,26 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "TRANS", Offset: 0, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Ligne droite"},
+					{Value: 1, Meaning: "Virage à droite"},
+					{Value: 2, Meaning: "Virage à gauche"},
+					{Value: 3, Meaning: "Tendance indéterminée"},
+				}},
+				{Name: "LONGI", Offset: 2, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Vitesse sol constante"},
+					{Value: 1, Meaning: "Vitesse sol en augmentation"},
+					{Value: 2, Meaning: "Vitesse sol en diminution"},
+					{Value: 3, Meaning: "Tendance indéterminée"},
+				}},
+				{Name: "VERTI", Offset: 4, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Vol en palier"},
+					{Value: 1, Meaning: "Vol en montée"},
+					{Value: 2, Meaning: "Vol en descente"},
+					{Value: 3, Meaning: "Tendance indéterminée"},
+				}},
+			},
 		},
 		{
 			FRN:      13,
@@ -109,6 +215,9 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "TAUX", Description: "Taux de montée/descente", Offset: 0, Width: 16, Signed: true, LSB: 5.859375, Unit: "FL/min"},
+			},
 		},
 		{
 			FRN:      14,
@@ -118,6 +227,32 @@ var Cat030StrV51 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "SY", Offset: 0, Width: 5},
+				{Name: "M", Offset: 5, Width: 1},
+				{Name: "S", Offset: 6, Width: 1},
+				{Name: "O19", Offset: 8, Width: 1},
+				{Name: "O18", Offset: 9, Width: 1},
+				{Name: "O17", Offset: 10, Width: 1},
+				{Name: "O16", Offset: 11, Width: 1},
+				{Name: "O15", Offset: 12, Width: 1},
+				{Name: "O14", Offset: 13, Width: 1},
+				{Name: "O13", Offset: 14, Width: 1},
+				{Name: "O12", Offset: 16, Width: 1},
+				{Name: "O11", Offset: 17, Width: 1},
+				{Name: "O10", Offset: 18, Width: 1},
+				{Name: "O9", Offset: 19, Width: 1},
+				{Name: "O8", Offset: 20, Width: 1},
+				{Name: "O7", Offset: 21, Width: 1},
+				{Name: "O6", Offset: 22, Width: 1},
+				{Name: "O5", Offset: 24, Width: 1},
+				{Name: "O4", Offset: 25, Width: 1},
+				{Name: "O3", Offset: 26, Width: 1},
+				{Name: "O2", Offset: 27, Width: 1},
+				{Name: "O1", Offset: 28, Width: 1},
+				{Name: "R", Offset: 29, Width: 1},
+				{Name: "C", Offset: 30, Width: 1},
+			},
 		},
 		{
 			FRN:      15,
@@ -126,6 +261,10 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:      16,
@@ -142,6 +281,9 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "PLN", Description: "Numéro de plan de vol CAUTRA", Offset: 0, Width: 16},
+			},
 		},
 		{
 			FRN:      18,
@@ -182,6 +324,10 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "QNC", Offset: 0, Width: 1},
+				{Name: "ALT", Description: "Altitude calculée", Offset: 1, Width: 15},
+			},
 		},
 		{
 			FRN:      23,
@@ -190,6 +336,9 @@ var Cat030StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "ADRS", Description: "Adresse mode S", Offset: 0, Width: 24},
+			},
 		},
 		{
 			FRN:      24,
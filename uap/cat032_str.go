@@ -6,6 +6,7 @@ package uap
 var Cat032StrV70 = StandardUAP{
 	Category: 32,
 	Version:  7.0,
+	Edition:  Edition{Major: 7, Minor: 0},
 	Items: []DataField{
 		{
 			FRN:      1,
@@ -14,6 +15,10 @@ var Cat032StrV70 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:      2,
@@ -5,6 +5,7 @@ package uap
 var Cat034V127 = StandardUAP{
 	Category: 34,
 	Version:  1.27,
+	Edition:  Edition{Major: 1, Minor: 27},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -14,6 +15,10 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         2,
@@ -23,6 +28,14 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "MT", Description: "Message Type", Offset: 0, Width: 8, Values: []ValueTable{
+					{Value: 1, Meaning: "North Marker message"},
+					{Value: 2, Meaning: "Sector crossing message"},
+					{Value: 3, Meaning: "Geographical filtering message"},
+					{Value: 4, Meaning: "Jamming Strobe message"},
+				}},
+			},
 		},
 		{
 			FRN:         3,
@@ -32,6 +45,9 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TOD", Description: "Time-of-Day", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         4,
@@ -41,6 +57,9 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "SN", Description: "Sector Number", Offset: 0, Width: 8, LSB: 360.0 / 256, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         5,
@@ -50,6 +69,9 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "ARS", Description: "Antenna Rotation Speed", Offset: 0, Width: 16, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN: 6, DataItem: "I034/050",
@@ -64,6 +86,15 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "NOGO", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "System is released for operational use"}, {Value: 1, Meaning: "Operational use of System is inhibited, i.e. the data shall be discarded by an operational SDPS"}}},
+						{Name: "RDPC", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "RDPC-1 selected"}, {Value: 1, Meaning: "RDPC-2 selected"}}},
+						{Name: "RDPR", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default situation"}, {Value: 1, Meaning: "Reset of RDPC"}}},
+						{Name: "OVLRDP", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default, no overload"}, {Value: 1, Meaning: "Overload in RDP"}}},
+						{Name: "OVLXMT", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default, no overload"}, {Value: 1, Meaning: "Overload in transmission subsystem"}}},
+						{Name: "MSC", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Monitoring system connected"}, {Value: 1, Meaning: "Monitoring system disconnected"}}},
+						{Name: "TSV", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Valid"}, {Value: 1, Meaning: "Invalid"}}},
+					},
 				},
 				{
 					FRN:  2,
@@ -81,6 +112,17 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "ANT", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Antenna 1"}, {Value: 1, Meaning: "Antenna 2"}}},
+						{Name: "CHAB", Offset: 1, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "No channel selected"},
+							{Value: 1, Meaning: "Channel A only selected"},
+							{Value: 2, Meaning: "Channel B only selected"},
+							{Value: 3, Meaning: "Diversity mode ; Channel A and B selected"},
+						}},
+						{Name: "OVL", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No overload"}, {Value: 1, Meaning: "Overload"}}},
+						{Name: "MSC", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Monitoring system connected"}, {Value: 1, Meaning: "Monitoring system disconnected"}}},
+					},
 				},
 				{
 					FRN:         5,
@@ -90,6 +132,17 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "ANT", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Antenna 1"}, {Value: 1, Meaning: "Antenna 2"}}},
+						{Name: "CHAB", Offset: 1, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "No channel selected"},
+							{Value: 1, Meaning: "Channel A only selected"},
+							{Value: 2, Meaning: "Channel B only selected"},
+							{Value: 3, Meaning: "Invalid combination"},
+						}},
+						{Name: "OVL", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No overload"}, {Value: 1, Meaning: "Overload"}}},
+						{Name: "MSC", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Monitoring system connected"}, {Value: 1, Meaning: "Monitoring system disconnected"}}},
+					},
 				},
 				{
 					FRN:         6,
@@ -99,6 +152,21 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "ANT", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Antenna 1"}, {Value: 1, Meaning: "Antenna 2"}}},
+						{Name: "CHAB", Offset: 1, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "No channel selected"},
+							{Value: 1, Meaning: "Channel A only selected"},
+							{Value: 2, Meaning: "Channel B only selected"},
+							{Value: 3, Meaning: "Illegal combination"},
+						}},
+						{Name: "OVLSUR", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No overload"}, {Value: 1, Meaning: "Overload"}}},
+						{Name: "MSC", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Monitoring system connected"}, {Value: 1, Meaning: "Monitoring system disconnected"}}},
+						{Name: "SCF", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Channel A in use"}, {Value: 1, Meaning: "Channel B in use"}}},
+						{Name: "DLF", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Channel A in use"}, {Value: 1, Meaning: "Channel B in use"}}},
+						{Name: "OVLSCF", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No overload"}, {Value: 1, Meaning: "Overload"}}},
+						{Name: "OVLDLF", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No overload"}, {Value: 1, Meaning: "Overload"}}},
+					},
 				},
 				{
 					FRN:  7,
@@ -119,6 +187,10 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "REDRDP", Offset: 1, Width: 3},
+						{Name: "REDXMT", Offset: 4, Width: 3},
+					},
 				},
 				{
 					FRN:  2,
@@ -136,6 +208,16 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "POL", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Linear polarization"}, {Value: 1, Meaning: "Circular polarization"}}},
+						{Name: "REDRAD", Offset: 1, Width: 3},
+						{Name: "STC", Offset: 4, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Map 1"},
+							{Value: 1, Meaning: "Map 2"},
+							{Value: 2, Meaning: "Map 3"},
+							{Value: 3, Meaning: "Map 4"},
+						}},
+					},
 				},
 				{
 					FRN:         5,
@@ -145,6 +227,9 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "REDRAD", Offset: 0, Width: 3},
+					},
 				},
 				{
 					FRN:         6,
@@ -154,6 +239,10 @@ var Cat034V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "REDRAD", Offset: 0, Width: 3},
+						{Name: "CLU", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Autonomous"}, {Value: 1, Meaning: "Not autonomous"}}},
+					},
 				},
 				{
 					FRN:  7,
@@ -169,6 +258,28 @@ var Cat034V127 = StandardUAP{
 			Repetitive: RepetitiveField{
 				SubItemSize: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "TYP", Description: "Type of message counter", Offset: 0, Width: 5, Values: []ValueTable{
+					{Value: 0, Meaning: "No detection (number of misses)"},
+					{Value: 1, Meaning: "Single PSR target reports"},
+					{Value: 2, Meaning: "Single SSR target reports (Non-Mode S)"},
+					{Value: 3, Meaning: "SSR+PSR target reports (Non-Mode S)"},
+					{Value: 4, Meaning: "Single All-Call target reports (Mode S)"},
+					{Value: 5, Meaning: "Single Roll-Call target reports (Mode S)"},
+					{Value: 6, Meaning: "All-Call + PSR (Mode S) target reports"},
+					{Value: 7, Meaning: "Roll-Call + PSR (Mode S) target reports"},
+					{Value: 8, Meaning: "Filter for Weather data"},
+					{Value: 9, Meaning: "Filter for Jamming Strobe"},
+					{Value: 10, Meaning: "Filter for PSR data"},
+					{Value: 11, Meaning: "Filter for SSR/Mode S data"},
+					{Value: 12, Meaning: "Filter for SSR/Mode S+PSR data"},
+					{Value: 13, Meaning: "Filter for Enhanced Surveillance data"},
+					{Value: 14, Meaning: "Filter for PSR+Enhanced Surveillance"},
+					{Value: 15, Meaning: "Filter for PSR+Enhanced Surveillance + SSR/Mode S data not in Area of Prime Interest"},
+					{Value: 16, Meaning: "Filter for PSR+Enhanced Surveillance + all SSR/Mode S data"},
+				}},
+				{Name: "COUNT", Description: "Counter for the type of message", Offset: 5, Width: 11},
+			},
 		},
 		{
 			FRN:         9,
@@ -178,6 +289,12 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 8,
 			},
+			Subfields: []Subfield{
+				{Name: "RHOST", Description: "Rho start", Offset: 0, Width: 16, LSB: 1.0 / 256, Unit: "NM"},
+				{Name: "RHOEND", Description: "Rho end", Offset: 16, Width: 16, LSB: 1.0 / 256, Unit: "NM"},
+				{Name: "THETAST", Description: "Theta start", Offset: 32, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+				{Name: "THETAEND", Description: "Theta end", Offset: 48, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         10,
@@ -187,6 +304,20 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "TYP", Offset: 0, Width: 8, Values: []ValueTable{
+					{Value: 0, Meaning: "Invalid value"},
+					{Value: 1, Meaning: "Filter for Weather data"},
+					{Value: 2, Meaning: "Filter for Jamming Strobe"},
+					{Value: 3, Meaning: "Filter for PSR data"},
+					{Value: 4, Meaning: "Filter for SSR/Mode S data"},
+					{Value: 5, Meaning: "Filter for SSR/Mode S + PSR data"},
+					{Value: 6, Meaning: "Enhanced Surveillance data"},
+					{Value: 7, Meaning: "Filter for PSR+Enhanced Surveillance data"},
+					{Value: 8, Meaning: "Filter for PSR+Enhanced Surveillance + SSR/Mode S data not in Area of Prime Interest"},
+					{Value: 9, Meaning: "Filter for PSR+Enhanced Surveillance + all SSR/Mode S data"},
+				}},
+			},
 		},
 		{
 			FRN:         11,
@@ -196,6 +327,11 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 8,
 			},
+			Subfields: []Subfield{
+				{Name: "HGT", Description: "Height of data source", Offset: 0, Width: 16, Signed: true, LSB: 1, Unit: "m"},
+				{Name: "LAT", Description: "Latitude", Offset: 16, Width: 24, Signed: true, LSB: 180.0 / (1 << 23), Unit: "deg"},
+				{Name: "LON", Description: "Longitude", Offset: 40, Width: 24, Signed: true, LSB: 180.0 / (1 << 23), Unit: "deg"},
+			},
 		},
 		{
 			FRN:         12,
@@ -205,6 +341,10 @@ var Cat034V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "RE", Description: "Range error", Offset: 0, Width: 8, Signed: true, LSB: 1.0 / 128, Unit: "NM"},
+				{Name: "AE", Description: "Azimuth error", Offset: 8, Width: 8, Signed: true, LSB: 360.0 / 16384, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         13,
@@ -6,6 +6,7 @@ var Cat048V127 = StandardUAP{
 	Name:     "cat048_1.27",
 	Category: 48,
 	Version:  1.27,
+	Edition:  Edition{Major: 1, Minor: 27},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -15,6 +16,10 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         2,
@@ -24,6 +29,9 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TOD", Description: "Time of Day", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         3,
@@ -34,6 +42,33 @@ var Cat048V127 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "TYP", Offset: 0, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "No detection"},
+					{Value: 1, Meaning: "Single PSR detection"},
+					{Value: 2, Meaning: "Single SSR detection"},
+					{Value: 3, Meaning: "SSR + PSR detection"},
+					{Value: 4, Meaning: "Single ModeS All-Call"},
+					{Value: 5, Meaning: "Single ModeS Roll-Call"},
+					{Value: 6, Meaning: "ModeS All-Call + PSR"},
+					{Value: 7, Meaning: "ModeS Roll-Call + PSR"},
+				}},
+				{Name: "SIM", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Actual target report"}, {Value: 1, Meaning: "Simulated target report"}}},
+				{Name: "RDP", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Report from RDP Chain 1"}, {Value: 1, Meaning: "Report from RDP Chain 2"}}},
+				{Name: "SPI", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Absence of SPI"}, {Value: 1, Meaning: "Special Position Identification"}}},
+				{Name: "RAB", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Report from aircraft transponder"}, {Value: 1, Meaning: "Report from field monitor (fixed transponder)"}}},
+				{Name: "TST", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Real target report"}, {Value: 1, Meaning: "Test target report"}}},
+				{Name: "ERR", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No Extended Range"}, {Value: 1, Meaning: "Extended Range present"}}},
+				{Name: "XPP", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No X-Pulse present"}, {Value: 1, Meaning: "X-Pulse present"}}},
+				{Name: "ME", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No military emergency"}, {Value: 1, Meaning: "Military emergency"}}},
+				{Name: "MI", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No military identification"}, {Value: 1, Meaning: "Military identification"}}},
+				{Name: "FOEFRI", Offset: 13, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "No Mode 4 interrogation"},
+					{Value: 1, Meaning: "Friendly target"},
+					{Value: 2, Meaning: "Unknown target"},
+					{Value: 3, Meaning: "No reply"},
+				}},
+			},
 		},
 		{
 			FRN:         4,
@@ -43,6 +78,10 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "RHO", Description: "Measured distance", Offset: 0, Width: 16, LSB: 1.0 / 256, Unit: "NM"},
+				{Name: "THETA", Description: "Measured azimuth", Offset: 16, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         5,
@@ -52,6 +91,15 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+				{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{
+					{Value: 0, Meaning: "Mode-3/A code derived from the reply of the transponder"},
+					{Value: 1, Meaning: "Mode-3/A code not extracted during the last scan"},
+				}},
+				{Name: "MODE3A", Description: "Mode-3/A reply in octal representation", Offset: 4, Width: 12},
+			},
 		},
 		{
 			FRN:         6,
@@ -61,6 +109,11 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+				{Name: "FL", Description: "Flight Level", Offset: 2, Width: 14, Signed: true, LSB: 0.25, Unit: "FL"},
+			},
 		},
 		{
 			FRN:         7,
@@ -76,6 +129,9 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "SRL", Offset: 0, Width: 8, LSB: 360.0 / 8192, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         2,
@@ -85,6 +141,9 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "SRR", Offset: 0, Width: 8},
+					},
 				},
 				{
 					FRN:         3,
@@ -94,6 +153,9 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "SAM", Offset: 0, Width: 8, Signed: true, Unit: "dBm"},
+					},
 				},
 				{
 					FRN:         4,
@@ -103,6 +165,9 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "PRL", Offset: 0, Width: 8, LSB: 360.0 / 8192, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         5,
@@ -112,6 +177,9 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "PAM", Offset: 0, Width: 8, Signed: true, Unit: "dBm"},
+					},
 				},
 				{
 					FRN:         6,
@@ -121,6 +189,9 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "RPD", Offset: 0, Width: 8, Signed: true, LSB: 1.0 / 256, Unit: "NM"},
+					},
 				},
 				{
 					FRN:         7,
@@ -130,6 +201,9 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "APD", Offset: 0, Width: 8, Signed: true, LSB: 360.0 / 16384, Unit: "deg"},
+					},
 				},
 			},
 		},
@@ -142,6 +216,9 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "ACADDR", Description: "Aircraft address", Offset: 0, Width: 24},
+			},
 		},
 		{
 			FRN:         9,
@@ -160,6 +237,11 @@ var Cat048V127 = StandardUAP{
 			Repetitive: RepetitiveField{
 				SubItemSize: 8,
 			},
+			Subfields: []Subfield{
+				{Name: "MBDATA", Description: "56-bit message conveying Mode S Comm B message data", Offset: 0, Width: 56},
+				{Name: "BDS1", Description: "Comm B Data Buffer Store 1 Address", Offset: 56, Width: 4},
+				{Name: "BDS2", Description: "Comm B Data Buffer Store 2 Address", Offset: 60, Width: 4},
+			},
 		},
 		{
 			FRN:         11,
@@ -169,6 +251,9 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "TRN", Description: "Track Number", Offset: 4, Width: 12},
+			},
 		},
 		{
 			FRN:         12,
@@ -178,6 +263,10 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "X", Description: "X-Component", Offset: 0, Width: 16, Signed: true, LSB: 1.0 / 128, Unit: "NM"},
+				{Name: "Y", Description: "Y-Component", Offset: 16, Width: 16, Signed: true, LSB: 1.0 / 128, Unit: "NM"},
+			},
 		},
 		{
 			FRN:         13,
@@ -187,6 +276,10 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "GSP", Description: "Calculated groundspeed", Offset: 0, Width: 16, LSB: 1.0 / 16384, Unit: "NM/s"},
+				{Name: "HDG", Description: "Calculated heading", Offset: 16, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         14,
@@ -197,6 +290,27 @@ var Cat048V127 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "CNF", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Confirmed track"}, {Value: 1, Meaning: "Tentative track"}}},
+				{Name: "RAD", Offset: 1, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Combined track"},
+					{Value: 1, Meaning: "PSR track"},
+					{Value: 2, Meaning: "SSR/Mode S track"},
+					{Value: 3, Meaning: "Invalid"},
+				}},
+				{Name: "DOU", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Normal confidence"}, {Value: 1, Meaning: "Low confidence in plot to track association"}}},
+				{Name: "MAH", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No horizontal man. sensed"}, {Value: 1, Meaning: "Horizontal man. sensed"}}},
+				{Name: "CDM", Offset: 5, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Maintaining"},
+					{Value: 1, Meaning: "Climbing"},
+					{Value: 2, Meaning: "Descending"},
+					{Value: 3, Meaning: "Unknown"},
+				}},
+				{Name: "TRE", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Track still alive"}, {Value: 1, Meaning: "End of track lifetime (last report for this track)"}}},
+				{Name: "GHO", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "True target track"}, {Value: 1, Meaning: "Ghost target track"}}},
+				{Name: "SUP", Description: "Track maintained with track information from neighbouring Node B on the cluster, or network", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No"}, {Value: 1, Meaning: "Yes"}}},
+				{Name: "TCC", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Tracking performed in so-called 'Radar Plane', i.e. neither slant range correction nor stereographical projection was applied"}, {Value: 1, Meaning: "Slant range correction and a suitable projection technique are used to track in a 2D reference plane, tangential to the earth model at the Radar Site co-ordinates"}}},
+			},
 		},
 		{
 			FRN:         15,
@@ -206,6 +320,12 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "SIGX", Description: "Sigma (X) standard deviation on the horizontal axis of the local grid system", Offset: 0, Width: 8, LSB: 1.0 / 128, Unit: "NM"},
+				{Name: "SIGY", Description: "Sigma (Y) standard deviation on the vertical axis of the local grid system", Offset: 8, Width: 8, LSB: 1.0 / 128, Unit: "NM"},
+				{Name: "SIGV", Description: "Sigma (V) standard deviation on the groundspeed within the local grid system", Offset: 16, Width: 8, LSB: 1.0 / 16384, Unit: "NM/s"},
+				{Name: "SIGH", Description: "Sigma (H) standard deviation on the heading within the local grid system", Offset: 24, Width: 8, LSB: 360.0 / 4096, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         16,
@@ -225,6 +345,20 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "QA4", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+				{Name: "QA2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+				{Name: "QA1", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+				{Name: "QB4", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+				{Name: "QB2", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+				{Name: "QB1", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+				{Name: "QC4", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+				{Name: "QC2", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+				{Name: "QC1", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+				{Name: "QD4", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+				{Name: "QD2", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+				{Name: "QD1", Offset: 15, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+			},
 		},
 		{
 			FRN:         18,
@@ -234,6 +368,23 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+				{Name: "MODEC", Description: "Mode-C reply in Gray notation", Offset: 4, Width: 12},
+				{Name: "QC1", Offset: 20, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+				{Name: "QA1", Offset: 21, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+				{Name: "QC2", Offset: 22, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+				{Name: "QA2", Offset: 23, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+				{Name: "QC4", Offset: 24, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+				{Name: "QA4", Offset: 25, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+				{Name: "QB1", Offset: 26, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+				{Name: "QD1", Offset: 27, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+				{Name: "QB2", Offset: 28, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+				{Name: "QD2", Offset: 29, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+				{Name: "QB4", Offset: 30, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+				{Name: "QD4", Offset: 31, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+			},
 		},
 		{
 			FRN:         19,
@@ -243,6 +394,9 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "3DH", Description: "3D height", Offset: 2, Width: 14, Signed: true, LSB: 25, Unit: "ft"},
+			},
 		},
 		{
 			FRN:         20,
@@ -258,6 +412,10 @@ var Cat048V127 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "D", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Doppler speed is valid"}, {Value: 1, Meaning: "Doppler speed is doubtful"}}},
+						{Name: "CAL", Description: "Calculated Doppler speed", Offset: 6, Width: 10, Signed: true, LSB: 1, Unit: "m/s"},
+					},
 				},
 				{
 					FRN:         2,
@@ -265,7 +423,12 @@ var Cat048V127 = StandardUAP{
 					Description: "Raw Doppler Speed",
 					Type:        Repetitive,
 					Repetitive: RepetitiveField{
-						SubItemSize: 2,
+						SubItemSize: 6,
+					},
+					Subfields: []Subfield{
+						{Name: "DOP", Description: "Doppler speed", Offset: 0, Width: 16, LSB: 1, Unit: "m/s"},
+						{Name: "AMB", Description: "Ambiguity range", Offset: 16, Width: 16, LSB: 1, Unit: "m/s"},
+						{Name: "FRQ", Description: "Transmitter frequency", Offset: 32, Width: 16, LSB: 1, Unit: "MHz"},
 					},
 				},
 				{
@@ -298,6 +461,31 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "COM", Description: "Communications capability of the transponder", Offset: 0, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "No communications capability (surveillance only)"},
+					{Value: 1, Meaning: "Comm. A and Comm. B capability"},
+					{Value: 2, Meaning: "Comm. A, Comm. B and Uplink ELM"},
+					{Value: 3, Meaning: "Comm. A, Comm. B, Uplink ELM and Downlink ELM"},
+					{Value: 4, Meaning: "Level 5 Transponder capability"},
+				}},
+				{Name: "STAT", Description: "Flight status", Offset: 3, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "No alert, no SPI, aircraft airborne"},
+					{Value: 1, Meaning: "No alert, no SPI, aircraft on ground"},
+					{Value: 2, Meaning: "Alert, no SPI, aircraft airborne"},
+					{Value: 3, Meaning: "Alert, no SPI, aircraft on ground"},
+					{Value: 4, Meaning: "Alert, SPI, aircraft airborne or on ground"},
+					{Value: 5, Meaning: "No alert, SPI, aircraft airborne or on ground"},
+					{Value: 6, Meaning: "Not assigned"},
+					{Value: 7, Meaning: "Unknown"},
+				}},
+				{Name: "SI", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "SI-Code Capable"}, {Value: 1, Meaning: "II-Code Capable"}}},
+				{Name: "MSSC", Description: "Mode-S Specific Service Capability", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No"}, {Value: 1, Meaning: "Yes"}}},
+				{Name: "ARC", Description: "Altitude reporting capability", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "100 ft resolution"}, {Value: 1, Meaning: "25 ft resolution"}}},
+				{Name: "AIC", Description: "Aircraft identification capability", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No"}, {Value: 1, Meaning: "Yes"}}},
+				{Name: "B1A", Description: "BDS 1,0 bit 16", Offset: 11, Width: 1},
+				{Name: "B1B", Description: "BDS 1,0 bits 37/40", Offset: 12, Width: 4},
+			},
 		},
 		{
 			FRN:         22,
@@ -307,6 +495,20 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 7,
 			},
+			Subfields: []Subfield{
+				{Name: "TYP", Description: "Message type (BDS register 3,0)", Offset: 0, Width: 8},
+				{Name: "ARA", Description: "Active resolution advisories", Offset: 8, Width: 14},
+				{Name: "RAC", Description: "RA complement record", Offset: 22, Width: 4},
+				{Name: "RAT", Offset: 26, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "ACAS is currently issuing the RAs indicated in ARA"}, {Value: 1, Meaning: "RA terminated"}}},
+				{Name: "MTE", Offset: 27, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "ACAS is resolving one threat"}, {Value: 1, Meaning: "ACAS is resolving multiple threats"}}},
+				{Name: "TTI", Description: "Threat type indicator", Offset: 28, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "No identity data in TID"},
+					{Value: 1, Meaning: "TID contains a Mode S transponder address"},
+					{Value: 2, Meaning: "TID contains altitude, range and bearing data"},
+					{Value: 3, Meaning: "Not assigned"},
+				}},
+				{Name: "TID", Description: "Threat identity data", Offset: 30, Width: 26},
+			},
 		},
 		{
 			FRN:         23,
@@ -316,6 +518,15 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+				{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{
+					{Value: 0, Meaning: "Mode-1 code as derived from the reply of the transponder"},
+					{Value: 1, Meaning: "Smoothed Mode-1 code as provided by a local tracker"},
+				}},
+				{Name: "MODE1", Description: "Mode-1 reply in octal representation", Offset: 3, Width: 5},
+			},
 		},
 		{
 			FRN:         24,
@@ -325,6 +536,15 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+				{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+				{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{
+					{Value: 0, Meaning: "Mode-2 code as derived from the reply of the transponder"},
+					{Value: 1, Meaning: "Smoothed Mode-2 code as provided by a local tracker"},
+				}},
+				{Name: "MODE2", Description: "Mode-2 reply in octal representation", Offset: 4, Width: 12},
+			},
 		},
 		{
 			FRN:         25,
@@ -334,6 +554,13 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "QA4", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+				{Name: "QA2", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+				{Name: "QA1", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+				{Name: "QB2", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+				{Name: "QB1", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+			},
 		},
 		{
 			FRN:         26,
@@ -343,6 +570,20 @@ var Cat048V127 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "QA4", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A4"}, {Value: 1, Meaning: "Low quality pulse A4"}}},
+				{Name: "QA2", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A2"}, {Value: 1, Meaning: "Low quality pulse A2"}}},
+				{Name: "QA1", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse A1"}, {Value: 1, Meaning: "Low quality pulse A1"}}},
+				{Name: "QB4", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B4"}, {Value: 1, Meaning: "Low quality pulse B4"}}},
+				{Name: "QB2", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B2"}, {Value: 1, Meaning: "Low quality pulse B2"}}},
+				{Name: "QB1", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse B1"}, {Value: 1, Meaning: "Low quality pulse B1"}}},
+				{Name: "QC4", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C4"}, {Value: 1, Meaning: "Low quality pulse C4"}}},
+				{Name: "QC2", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C2"}, {Value: 1, Meaning: "Low quality pulse C2"}}},
+				{Name: "QC1", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse C1"}, {Value: 1, Meaning: "Low quality pulse C1"}}},
+				{Name: "QD4", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D4"}, {Value: 1, Meaning: "Low quality pulse D4"}}},
+				{Name: "QD2", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D2"}, {Value: 1, Meaning: "Low quality pulse D2"}}},
+				{Name: "QD1", Offset: 15, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "High quality pulse D1"}, {Value: 1, Meaning: "Low quality pulse D1"}}},
+			},
 		},
 		{
 			FRN:         27,
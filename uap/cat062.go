@@ -6,6 +6,7 @@ var Cat062V119 = StandardUAP{
 	Name:     "cat062_1.19",
 	Category: 62,
 	Version:  1.19,
+	Edition:  Edition{Major: 1, Minor: 19},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -15,6 +16,10 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:      2,
@@ -29,6 +34,9 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "SID", Description: "Service Identification", Offset: 0, Width: 8},
+			},
 		},
 		{
 			FRN:         4,
@@ -38,6 +46,9 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TOT", Description: "Time Of Track Information", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         5,
@@ -47,6 +58,10 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 8,
 			},
+			Subfields: []Subfield{
+				{Name: "LAT", Description: "Latitude in WGS-84", Offset: 0, Width: 32, Signed: true, LSB: 180.0 / (1 << 25), Unit: "deg"},
+				{Name: "LON", Description: "Longitude in WGS-84", Offset: 32, Width: 32, Signed: true, LSB: 180.0 / (1 << 25), Unit: "deg"},
+			},
 		},
 		{
 			FRN:         6,
@@ -56,6 +71,10 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 6,
 			},
+			Subfields: []Subfield{
+				{Name: "X", Offset: 0, Width: 24, Signed: true, LSB: 0.5, Unit: "m"},
+				{Name: "Y", Offset: 24, Width: 24, Signed: true, LSB: 0.5, Unit: "m"},
+			},
 		},
 		{
 			FRN:         7,
@@ -65,6 +84,10 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "VX", Offset: 0, Width: 16, Signed: true, LSB: 0.25, Unit: "m/s"},
+				{Name: "VY", Offset: 16, Width: 16, Signed: true, LSB: 0.25, Unit: "m/s"},
+			},
 		},
 		{
 			FRN:         8,
@@ -74,6 +97,10 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "AX", Offset: 0, Width: 8, Signed: true, LSB: 0.25, Unit: "m/s2"},
+				{Name: "AY", Offset: 8, Width: 8, Signed: true, LSB: 0.25, Unit: "m/s2"},
+			},
 		},
 		//FX : Field Extension Indicator
 		{
@@ -84,6 +111,10 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "CH", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No Change"}, {Value: 1, Meaning: "Mode 3/A has changed"}}},
+				{Name: "MODE3A", Description: "Mode-3/A reply in octal representation", Offset: 4, Width: 12},
+			},
 		},
 		{
 			FRN:         10,
@@ -108,6 +139,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 3,
 					},
+					Subfields: []Subfield{
+						{Name: "ADR", Description: "Target address", Offset: 0, Width: 24},
+					},
 				},
 				{
 					FRN:         2,
@@ -126,6 +160,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "MHG", Description: "Magnetic heading", Offset: 0, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         4,
@@ -135,6 +172,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "IM", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Air speed = IAS, LSB (Bit-1) = 2^-14 NM/s"}, {Value: 1, Meaning: "Air speed = Mach, LSB (Bit-1) = 0.001"}}},
+						{Name: "IAS", Description: "Indicated airspeed or Mach number, the LSB is given by IM", Offset: 1, Width: 15},
+					},
 				},
 				{
 					FRN:         5,
@@ -144,6 +185,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "TAS", Description: "True airspeed", Offset: 0, Width: 16, LSB: 1, Unit: "kt"},
+					},
 				},
 				{
 					FRN:         6,
@@ -153,6 +197,16 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "SAS", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No source information provided"}, {Value: 1, Meaning: "Source information provided"}}},
+						{Name: "SRC", Description: "Source", Offset: 1, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "Aircraft altitude"},
+							{Value: 2, Meaning: "FCU/MCP selected altitude"},
+							{Value: 3, Meaning: "FMS selected altitude"},
+						}},
+						{Name: "ALT", Description: "Altitude", Offset: 3, Width: 13, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         7,
@@ -162,6 +216,12 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "MV", Description: "Manage vertical mode", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not active or unknown"}, {Value: 1, Meaning: "Active"}}},
+						{Name: "AH", Description: "Altitude hold mode", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not active or unknown"}, {Value: 1, Meaning: "Active"}}},
+						{Name: "AM", Description: "Approach mode", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not active or unknown"}, {Value: 1, Meaning: "Active"}}},
+						{Name: "ALT", Description: "Altitude", Offset: 3, Width: 13, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				// FX
 				{
@@ -173,6 +233,10 @@ var Cat062V119 = StandardUAP{
 						PrimarySize:   1,
 						SecondarySize: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "NAV", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Trajectory Intent Data is available for this aircraft"}, {Value: 1, Meaning: "Trajectory Intent Data is not available for this aircraft"}}},
+						{Name: "NVB", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Trajectory Intent Data is valid"}, {Value: 1, Meaning: "Trajectory Intent Data is not valid"}}},
+					},
 				},
 				{
 					FRN:         9,
@@ -182,6 +246,38 @@ var Cat062V119 = StandardUAP{
 					Repetitive: RepetitiveField{
 						SubItemSize: 15,
 					},
+					Subfields: []Subfield{
+						{Name: "TCA", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "TCP number available"}, {Value: 1, Meaning: "TCP number not available"}}},
+						{Name: "NC", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "TCP compliance"}, {Value: 1, Meaning: "TCP non-compliance"}}},
+						{Name: "TCP", Description: "Trajectory Change Point number", Offset: 2, Width: 6},
+						{Name: "ALT", Description: "Altitude", Offset: 8, Width: 16, Signed: true, LSB: 10, Unit: "ft"},
+						{Name: "LAT", Description: "Latitude", Offset: 24, Width: 24, Signed: true, LSB: 180.0 / 8388608, Unit: "deg"},
+						{Name: "LON", Description: "Longitude", Offset: 48, Width: 24, Signed: true, LSB: 180.0 / 8388608, Unit: "deg"},
+						{Name: "PT", Description: "Point type", Offset: 72, Width: 4, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "Fly by waypoint (LT)"},
+							{Value: 2, Meaning: "Fly over waypoint (LT)"},
+							{Value: 3, Meaning: "Hold pattern (LT)"},
+							{Value: 4, Meaning: "Procedure hold (LT)"},
+							{Value: 5, Meaning: "Procedure turn (LT)"},
+							{Value: 6, Meaning: "RF leg (LT)"},
+							{Value: 7, Meaning: "Top of climb (VT)"},
+							{Value: 8, Meaning: "Top of descent (VT)"},
+							{Value: 9, Meaning: "Start of level (VT)"},
+							{Value: 10, Meaning: "Cross-over altitude (VT)"},
+							{Value: 11, Meaning: "Transition altitude (VT)"},
+						}},
+						{Name: "TD", Offset: 76, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "N/A"},
+							{Value: 1, Meaning: "Turn right"},
+							{Value: 2, Meaning: "Turn left"},
+							{Value: 3, Meaning: "No turn"},
+						}},
+						{Name: "TRA", Offset: 78, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "TTR not available"}, {Value: 1, Meaning: "TTR available"}}},
+						{Name: "TOA", Offset: 79, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "TOV available"}, {Value: 1, Meaning: "TOV not available"}}},
+						{Name: "TOV", Description: "Time Over Point", Offset: 80, Width: 24, LSB: 1, Unit: "s"},
+						{Name: "TTR", Description: "TCP Turn radius", Offset: 104, Width: 16, LSB: 0.01, Unit: "NM"},
+					},
 				},
 				{
 					FRN:         10,
@@ -191,6 +287,30 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "COM", Description: "Communications capability of the transponder", Offset: 0, Width: 3, Values: []ValueTable{
+							{Value: 0, Meaning: "No communications capability (surveillance only)"},
+							{Value: 1, Meaning: "Comm. A and Comm. B capability"},
+							{Value: 2, Meaning: "Comm. A, Comm. B and Uplink ELM"},
+							{Value: 3, Meaning: "Comm. A, Comm. B, Uplink ELM and Downlink ELM"},
+							{Value: 4, Meaning: "Level 5 Transponder capability"},
+						}},
+						{Name: "STAT", Description: "Flight status", Offset: 3, Width: 3, Values: []ValueTable{
+							{Value: 0, Meaning: "No alert, no SPI, aircraft airborne"},
+							{Value: 1, Meaning: "No alert, no SPI, aircraft on ground"},
+							{Value: 2, Meaning: "Alert, no SPI, aircraft airborne"},
+							{Value: 3, Meaning: "Alert, no SPI, aircraft on ground"},
+							{Value: 4, Meaning: "Alert, SPI, aircraft airborne or on ground"},
+							{Value: 5, Meaning: "No alert, SPI, aircraft airborne or on ground"},
+							{Value: 6, Meaning: "Not assigned"},
+							{Value: 7, Meaning: "Information not yet extracted"},
+						}},
+						{Name: "SSC", Description: "Specific service capability", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No"}, {Value: 1, Meaning: "Yes"}}},
+						{Name: "ARC", Description: "Altitude reporting capability", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "100 ft resolution"}, {Value: 1, Meaning: "25 ft resolution"}}},
+						{Name: "AIC", Description: "Aircraft identification capability", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No"}, {Value: 1, Meaning: "Yes"}}},
+						{Name: "B1A", Description: "BDS 1,0 bit 16", Offset: 11, Width: 1},
+						{Name: "B1B", Description: "BDS 1,0 bits 37/40", Offset: 12, Width: 4},
+					},
 				},
 				{
 					FRN:         11,
@@ -200,6 +320,37 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "AC", Description: "ACAS status", Offset: 0, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "ACAS not operational"},
+							{Value: 2, Meaning: "ACAS operational"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+						{Name: "MN", Description: "Multiple navigational aids status", Offset: 2, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "Multiple navigational aids not operating"},
+							{Value: 2, Meaning: "Multiple navigational aids operating"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+						{Name: "DC", Description: "Differential correction status", Offset: 4, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "Differential correction"},
+							{Value: 2, Meaning: "No differential correction"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+						{Name: "GBS", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Transponder Ground Bit not set or unknown"}, {Value: 1, Meaning: "Transponder Ground Bit set"}}},
+						{Name: "STAT", Description: "Flight status", Offset: 13, Width: 3, Values: []ValueTable{
+							{Value: 0, Meaning: "No emergency"},
+							{Value: 1, Meaning: "General emergency"},
+							{Value: 2, Meaning: "Lifeguard / medical"},
+							{Value: 3, Meaning: "Minimum fuel"},
+							{Value: 4, Meaning: "No communications"},
+							{Value: 5, Meaning: "Unlawful interference"},
+							{Value: 6, Meaning: "Downed Aircraft"},
+							{Value: 7, Meaning: "Unknown"},
+						}},
+					},
 				},
 				{
 					FRN:         12,
@@ -209,6 +360,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 7,
 					},
+					Subfields: []Subfield{
+						{Name: "ACS", Description: "Mode S Comm B message data of BDS register 1,0", Offset: 0, Width: 56},
+					},
 				},
 				{
 					FRN:         13,
@@ -218,6 +372,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "BVR", Description: "Barometric vertical rate", Offset: 0, Width: 16, Signed: true, LSB: 6.25, Unit: "ft/min"},
+					},
 				},
 				{
 					FRN:         14,
@@ -227,6 +384,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "GVR", Description: "Geometric vertical rate", Offset: 0, Width: 16, Signed: true, LSB: 6.25, Unit: "ft/min"},
+					},
 				},
 				// FX
 				{
@@ -237,6 +397,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "RAN", Description: "Roll angle", Offset: 0, Width: 16, Signed: true, LSB: 0.01, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         16,
@@ -246,6 +409,15 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "TI", Description: "Turn indicator", Offset: 0, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Not available"},
+							{Value: 1, Meaning: "Left"},
+							{Value: 2, Meaning: "Right"},
+							{Value: 3, Meaning: "Straight"},
+						}},
+						{Name: "ROT", Description: "Rate of turn", Offset: 8, Width: 7, Signed: true, LSB: 0.25, Unit: "deg/s"},
+					},
 				},
 				{
 					FRN:         17,
@@ -255,6 +427,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "TAN", Description: "Track angle", Offset: 0, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         18,
@@ -264,6 +439,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "GSP", Description: "Ground speed", Offset: 0, Width: 16, Signed: true, LSB: 1.0 / 16384, Unit: "NM/s"},
+					},
 				},
 				{
 					FRN:         19,
@@ -273,6 +451,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "VUC", Description: "Velocity uncertainty category", Offset: 0, Width: 8},
+					},
 				},
 				{
 					FRN:         20,
@@ -282,6 +463,16 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 8,
 					},
+					Subfields: []Subfield{
+						{Name: "WSV", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not valid wind speed"}, {Value: 1, Meaning: "Valid wind speed"}}},
+						{Name: "WDV", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not valid wind direction"}, {Value: 1, Meaning: "Valid wind direction"}}},
+						{Name: "TMPV", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not valid temperature"}, {Value: 1, Meaning: "Valid temperature"}}},
+						{Name: "TRBV", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not valid turbulence"}, {Value: 1, Meaning: "Valid turbulence"}}},
+						{Name: "WS", Description: "Wind speed", Offset: 8, Width: 16, LSB: 1, Unit: "kt"},
+						{Name: "WD", Description: "Wind direction", Offset: 24, Width: 16, LSB: 1, Unit: "deg"},
+						{Name: "TMP", Description: "Temperature", Offset: 40, Width: 16, Signed: true, LSB: 0.25, Unit: "degC"},
+						{Name: "TRB", Description: "Turbulence", Offset: 56, Width: 8},
+					},
 				},
 				{
 					FRN:         21,
@@ -291,6 +482,29 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "ECAT", Description: "Emitter category", Offset: 0, Width: 8, Values: []ValueTable{
+							{Value: 0, Meaning: "No ADS-B Emitter Category Information"},
+							{Value: 1, Meaning: "Light aircraft <= 15500 lbs"},
+							{Value: 2, Meaning: "15500 lbs < small aircraft < 75000 lbs"},
+							{Value: 3, Meaning: "75000 lbs < medium a/c < 300000 lbs"},
+							{Value: 4, Meaning: "High Vortex Large"},
+							{Value: 5, Meaning: "300000 lbs <= heavy aircraft"},
+							{Value: 6, Meaning: "Highly manoeuvrable (5g acceleration capability) and high speed (>400 knots cruise)"},
+							{Value: 10, Meaning: "Rotocraft"},
+							{Value: 11, Meaning: "Glider / sailplane"},
+							{Value: 12, Meaning: "Lighter-than-air"},
+							{Value: 13, Meaning: "Unmanned aerial vehicle"},
+							{Value: 14, Meaning: "Space / transatmospheric vehicle"},
+							{Value: 15, Meaning: "Ultralight / handglider / paraglider"},
+							{Value: 16, Meaning: "Parachutist / skydiver"},
+							{Value: 20, Meaning: "Surface emergency vehicle"},
+							{Value: 21, Meaning: "Surface service vehicle"},
+							{Value: 22, Meaning: "Fixed ground or tethered obstruction"},
+							{Value: 23, Meaning: "Cluster obstacle"},
+							{Value: 24, Meaning: "Line obstacle"},
+						}},
+					},
 				},
 				// FX
 				{
@@ -301,6 +515,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 6,
 					},
+					Subfields: []Subfield{
+						{Name: "LAT", Description: "Latitude", Offset: 0, Width: 24, Signed: true, LSB: 180.0 / 8388608, Unit: "deg"},
+						{Name: "LON", Description: "Longitude", Offset: 24, Width: 24, Signed: true, LSB: 180.0 / 8388608, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         23,
@@ -310,6 +528,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "GAL", Description: "Geometric altitude", Offset: 0, Width: 16, Signed: true, LSB: 6.25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         24,
@@ -319,6 +540,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "PUN", Description: "Position uncertainty", Offset: 4, Width: 4},
+					},
 				},
 				{
 					FRN:         25,
@@ -328,6 +552,11 @@ var Cat062V119 = StandardUAP{
 					Repetitive: RepetitiveField{
 						SubItemSize: 8,
 					},
+					Subfields: []Subfield{
+						{Name: "MBDATA", Description: "56-bit message conveying Mode S Comm B message data", Offset: 0, Width: 56},
+						{Name: "BDS1", Description: "Comm B Data Buffer Store 1 Address", Offset: 56, Width: 4},
+						{Name: "BDS2", Description: "Comm B Data Buffer Store 2 Address", Offset: 60, Width: 4},
+					},
 				},
 				{
 					FRN:         26,
@@ -337,6 +566,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "IAR", Description: "Indicated airspeed", Offset: 0, Width: 16, LSB: 1, Unit: "kt"},
+					},
 				},
 				{
 					FRN:         27,
@@ -346,6 +578,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "MAC", Description: "Mach number", Offset: 0, Width: 16, LSB: 0.008},
+					},
 				},
 				{
 					FRN:         28,
@@ -355,6 +590,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "BPS", Description: "Barometric pressure setting minus 800 mb", Offset: 4, Width: 12, LSB: 0.1, Unit: "mb"},
+					},
 				},
 			},
 		},
@@ -366,6 +604,9 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "TRK", Description: "Track Number", Offset: 0, Width: 16},
+			},
 		},
 		{
 			FRN:         13,
@@ -376,6 +617,75 @@ var Cat062V119 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "MON", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Multisensor track"}, {Value: 1, Meaning: "Monosensor track"}}},
+				{Name: "SPI", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "SPI present in the last report received from a sensor capable of decoding this data"}}},
+				{Name: "MRH", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Barometric altitude (Mode C) more reliable"}, {Value: 1, Meaning: "Geometric altitude more reliable"}}},
+				{Name: "SRC", Offset: 3, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "No source"},
+					{Value: 1, Meaning: "GNSS"},
+					{Value: 2, Meaning: "3D radar"},
+					{Value: 3, Meaning: "Triangulation"},
+					{Value: 4, Meaning: "Height from coverage"},
+					{Value: 5, Meaning: "Speed look-up table"},
+					{Value: 6, Meaning: "Default height"},
+					{Value: 7, Meaning: "Multilateration"},
+				}},
+				{Name: "CNF", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Confirmed track"}, {Value: 1, Meaning: "Tentative track"}}},
+				{Name: "SIM", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Actual track"}, {Value: 1, Meaning: "Simulated track"}}},
+				{Name: "TSE", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Last message transmitted to the user for the track"}}},
+				{Name: "TSB", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "First message transmitted to the user for the track"}}},
+				{Name: "FPC", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Not flight-plan correlated"}, {Value: 1, Meaning: "Flight plan correlated"}}},
+				{Name: "AFF", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "ADS-B data inconsistent with other surveillance information"}}},
+				{Name: "STP", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Slave Track Promotion"}}},
+				{Name: "KOS", Offset: 14, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Complementary service used"}, {Value: 1, Meaning: "Background service used"}}},
+				{Name: "AMA", Offset: 16, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Track not resulting from amalgamation process"}, {Value: 1, Meaning: "Track resulting from amalgamation process"}}},
+				{Name: "MD4", Offset: 17, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "No Mode 4 interrogation"},
+					{Value: 1, Meaning: "Friendly target"},
+					{Value: 2, Meaning: "Unknown target"},
+					{Value: 3, Meaning: "No reply"},
+				}},
+				{Name: "ME", Offset: 19, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Military Emergency present in the last report received from a sensor capable of decoding this data"}}},
+				{Name: "MI", Offset: 20, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Military Identification present in the last report received from a sensor capable of decoding this data"}}},
+				{Name: "MD5", Offset: 21, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "No Mode 5 interrogation"},
+					{Value: 1, Meaning: "Friendly target"},
+					{Value: 2, Meaning: "Unknown target"},
+					{Value: 3, Meaning: "No reply"},
+				}},
+				{Name: "CST", Offset: 24, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Age of the last received track update is higher than system dependent threshold (coasting)"}}},
+				{Name: "PSR", Offset: 25, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Age of the last received PSR track update is higher than system dependent threshold"}}},
+				{Name: "SSR", Offset: 26, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Age of the last received SSR track update is higher than system dependent threshold"}}},
+				{Name: "MDS", Offset: 27, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Age of the last received Mode S track update is higher than system dependent threshold"}}},
+				{Name: "ADS", Offset: 28, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Age of the last received ADS-B track update is higher than system dependent threshold"}}},
+				{Name: "SUC", Offset: 29, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Special Used Code"}}},
+				{Name: "AAC", Offset: 30, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Assigned Mode A Code Conflict"}}},
+				{Name: "SDS", Offset: 32, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Combined"},
+					{Value: 1, Meaning: "Co-operative only"},
+					{Value: 2, Meaning: "Non-Cooperative only"},
+					{Value: 3, Meaning: "Not defined"},
+				}},
+				{Name: "EMS", Offset: 34, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "No emergency"},
+					{Value: 1, Meaning: "General emergency"},
+					{Value: 2, Meaning: "Lifeguard / medical"},
+					{Value: 3, Meaning: "Minimum fuel"},
+					{Value: 4, Meaning: "No communications"},
+					{Value: 5, Meaning: "Unlawful interference"},
+					{Value: 6, Meaning: "Downed Aircraft"},
+					{Value: 7, Meaning: "Undefined"},
+				}},
+				{Name: "PFT", Offset: 37, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No indication"}, {Value: 1, Meaning: "Potential False Track Indication"}}},
+				{Name: "FPLT", Offset: 38, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Track created / updated with FPL data"}}},
+				{Name: "DUPT", Offset: 40, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Duplicate Mode 3/A Code"}}},
+				{Name: "DUPF", Offset: 41, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Duplicate Flight Plan"}}},
+				{Name: "DUPM", Offset: 42, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Duplicate Flight Plan due to manual correlation"}}},
+				{Name: "SFC", Offset: 43, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Surface target"}}},
+				{Name: "IDD", Offset: 44, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No indication"}, {Value: 1, Meaning: "Duplicate Flight-ID"}}},
+				{Name: "IEC", Offset: 45, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default value"}, {Value: 1, Meaning: "Inconsistent Emergency Code"}}},
+			},
 		},
 		{
 			FRN:         14,
@@ -391,6 +701,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TRK", Description: "Track age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         2,
@@ -400,6 +713,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "PSR", Description: "PSR age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         3,
@@ -409,6 +725,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "SSR", Description: "SSR age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         4,
@@ -418,6 +737,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MDS", Description: "Mode S age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         5,
@@ -427,6 +749,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "ADS", Description: "ADS-C age", Offset: 0, Width: 16, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         6,
@@ -436,6 +761,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "ES", Description: "ADS-B Extended Squitter age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         7,
@@ -445,6 +773,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "VDL", Description: "ADS-B VDL Mode 4 age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				// FX
 				{
@@ -455,6 +786,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "UAT", Description: "ADS-B UAT age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         9,
@@ -464,6 +798,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "LOP", Description: "Loop age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         10,
@@ -473,6 +810,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MLT", Description: "Multilateration age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:  11,
@@ -502,6 +842,27 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "TRANS", Offset: 0, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Constant Course"},
+					{Value: 1, Meaning: "Right Turn"},
+					{Value: 2, Meaning: "Left Turn"},
+					{Value: 3, Meaning: "Undetermined"},
+				}},
+				{Name: "LONG", Offset: 2, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Constant Groundspeed"},
+					{Value: 1, Meaning: "Increasing Groundspeed"},
+					{Value: 2, Meaning: "Decreasing Groundspeed"},
+					{Value: 3, Meaning: "Undetermined"},
+				}},
+				{Name: "VERT", Offset: 4, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Level"},
+					{Value: 1, Meaning: "Climb"},
+					{Value: 2, Meaning: "Descent"},
+					{Value: 3, Meaning: "Undetermined"},
+				}},
+				{Name: "ADF", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No altitude discrepancy"}, {Value: 1, Meaning: "Altitude discrepancy"}}},
+			},
 		},
 		{
 			FRN:         16,
@@ -517,6 +878,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MFL", Description: "Measured Flight Level age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         2,
@@ -526,6 +890,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MD1", Description: "Mode 1 age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         3,
@@ -535,6 +902,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MD2", Description: "Mode 2 age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         4,
@@ -544,6 +914,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MDA", Description: "Mode 3/A age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         5,
@@ -553,6 +926,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MD4", Description: "True Mode 4 age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         6,
@@ -562,6 +938,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MD5", Description: "Mode 5 age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         7,
@@ -571,6 +950,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MHG", Description: "Magnetic Heading age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				// FX
 				{
@@ -581,6 +963,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "IAS", Description: "Indicated Airspeed/Mach Nb age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         9,
@@ -590,6 +975,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TAS", Description: "True Airspeed age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         10,
@@ -599,6 +987,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "SAL", Description: "Selected ALtitude Age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         11,
@@ -608,6 +999,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "FSS", Description: "Final State Slected Altitude Age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         12,
@@ -617,6 +1011,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "COM", Description: "Communications / ACAS Capability and Flight Status age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         13,
@@ -626,6 +1023,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TID", Description: "Trajectory Intent Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         14,
@@ -635,6 +1035,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "SAB", Description: "Status Reported by ADS-B age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				// FX
 				{
@@ -645,6 +1048,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "ACS", Description: "ACAS Resolution Advisory Report age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         16,
@@ -654,6 +1060,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "BVR", Description: "Barometric Vertical Rate age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         17,
@@ -663,6 +1072,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "GVR", Description: "Geometric Vertical Rate age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         18,
@@ -672,6 +1084,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "RAN", Description: "Roll Angle age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         19,
@@ -681,6 +1096,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TAR", Description: "Track Angle Rate age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         20,
@@ -690,6 +1108,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TAN", Description: "Track Angle age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         21,
@@ -699,6 +1120,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "GSP", Description: "Ground Speed age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				// FX
 				{
@@ -709,6 +1133,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "VUN", Description: "Velocity Uncertainity age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         23,
@@ -718,6 +1145,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MET", Description: "Meteorological Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         24,
@@ -727,6 +1157,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "EMC", Description: "Emitter Category age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         25,
@@ -736,6 +1169,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "POS", Description: "Position Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         26,
@@ -745,6 +1181,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "GAL", Description: "Geometric Altitude Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         27,
@@ -754,6 +1193,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "PUN", Description: "Position Uncertainty Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         28,
@@ -763,6 +1205,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MB", Description: "Mode S MB Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				// FX
 				{
@@ -773,6 +1218,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "IAR", Description: "Indicated Airspeed Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         30,
@@ -782,6 +1230,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "MAC", Description: "Mac Number Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:         31,
@@ -791,6 +1242,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "BPS", Description: "Barometric Pressure Setting Data age", Offset: 0, Width: 8, LSB: 1.0 / 4, Unit: "s"},
+					},
 				},
 				{
 					FRN:  32,
@@ -819,6 +1273,9 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "MFL", Description: "Measured Flight Level", Offset: 0, Width: 16, Signed: true, LSB: 0.25, Unit: "FL"},
+			},
 		},
 		{
 			FRN:         18,
@@ -828,6 +1285,9 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "ALT", Description: "Altitude", Offset: 0, Width: 16, Signed: true, LSB: 6.25, Unit: "ft"},
+			},
 		},
 		{
 			FRN:         19,
@@ -837,6 +1297,10 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "QNH", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No QNH correction applied"}, {Value: 1, Meaning: "QNH correction applied"}}},
+				{Name: "CTB", Description: "Calculated Track Barometric Altitude", Offset: 1, Width: 15, Signed: true, LSB: 0.25, Unit: "FL"},
+			},
 		},
 		{
 			FRN:         20,
@@ -846,6 +1310,9 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "ROCD", Description: "Rate of Climb/Descent", Offset: 0, Width: 16, Signed: true, LSB: 6.25, Unit: "ft/min"},
+			},
 		},
 		{
 			FRN:         21,
@@ -861,6 +1328,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+						{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+					},
 				},
 				{
 					FRN:         2,
@@ -879,6 +1350,15 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "TYP", Offset: 0, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Plan number"},
+							{Value: 1, Meaning: "Unit 1 internal flight number"},
+							{Value: 2, Meaning: "Unit 2 internal flight number"},
+							{Value: 3, Meaning: "Unit 3 internal flight number"},
+						}},
+						{Name: "NBR", Description: "Number from 0 to 99 999 999", Offset: 5, Width: 27},
+					},
 				},
 				{
 					FRN:         4,
@@ -888,6 +1368,27 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "GATOAT", Offset: 0, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "General Air Traffic"},
+							{Value: 2, Meaning: "Operational Air Traffic"},
+							{Value: 3, Meaning: "Not applicable"},
+						}},
+						{Name: "FR1FR2", Offset: 2, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Instrument Flight Rules"},
+							{Value: 1, Meaning: "Visual Flight Rules"},
+							{Value: 2, Meaning: "Not applicable"},
+							{Value: 3, Meaning: "Controlled Visual Flight Rules"},
+						}},
+						{Name: "RVSM", Offset: 4, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Unknown"},
+							{Value: 1, Meaning: "Approved"},
+							{Value: 2, Meaning: "Exempt"},
+							{Value: 3, Meaning: "Not approved"},
+						}},
+						{Name: "HPR", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Normal priority flight"}, {Value: 1, Meaning: "High priority flight"}}},
+					},
 				},
 				{
 					FRN:         5,
@@ -943,6 +1444,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "CFL", Description: "Current cleared flight level", Offset: 0, Width: 16, LSB: 0.25, Unit: "FL"},
+					},
 				},
 				{
 					FRN:         11,
@@ -952,6 +1456,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "CENTRE", Description: "8-bit group identifying the centre", Offset: 0, Width: 8},
+						{Name: "POSITION", Description: "Identification of the control position", Offset: 8, Width: 8},
+					},
 				},
 				{
 					FRN:         12,
@@ -961,6 +1469,34 @@ var Cat062V119 = StandardUAP{
 					Repetitive: RepetitiveField{
 						SubItemSize: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "TYP", Offset: 0, Width: 5, Values: []ValueTable{
+							{Value: 0, Meaning: "Scheduled off-block time"},
+							{Value: 1, Meaning: "Estimated off-block time"},
+							{Value: 2, Meaning: "Estimated take-off time"},
+							{Value: 3, Meaning: "Actual off-block time"},
+							{Value: 4, Meaning: "Predicted time at runway hold"},
+							{Value: 5, Meaning: "Actual time at runway hold"},
+							{Value: 6, Meaning: "Actual line-up time"},
+							{Value: 7, Meaning: "Actual take-off time"},
+							{Value: 8, Meaning: "Estimated time of arrival"},
+							{Value: 9, Meaning: "Predicted landing time"},
+							{Value: 10, Meaning: "Actual landing time"},
+							{Value: 11, Meaning: "Actual time off runway"},
+							{Value: 12, Meaning: "Predicted time to gate"},
+							{Value: 13, Meaning: "Actual on-block time"},
+						}},
+						{Name: "DAY", Offset: 5, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Today"},
+							{Value: 1, Meaning: "Yesterday"},
+							{Value: 2, Meaning: "Tomorrow"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+						{Name: "HOR", Description: "Hours", Offset: 11, Width: 5, Unit: "h"},
+						{Name: "MIN", Description: "Minutes", Offset: 18, Width: 6, Unit: "min"},
+						{Name: "AVS", Offset: 24, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Seconds available"}, {Value: 1, Meaning: "Seconds not available"}}},
+						{Name: "SEC", Description: "Seconds", Offset: 26, Width: 6, Unit: "s"},
+					},
 				},
 				{
 					FRN:         13,
@@ -979,6 +1515,20 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "EMP", Offset: 0, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Empty"},
+							{Value: 1, Meaning: "Occupied"},
+							{Value: 2, Meaning: "Unknown"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+						{Name: "AVL", Offset: 2, Width: 2, Values: []ValueTable{
+							{Value: 0, Meaning: "Available"},
+							{Value: 1, Meaning: "Not available"},
+							{Value: 2, Meaning: "Unknown"},
+							{Value: 3, Meaning: "Invalid"},
+						}},
+					},
 				},
 				// FX
 				{
@@ -1007,6 +1557,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "VA", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No valid Mode 3/A available"}, {Value: 1, Meaning: "Valid Mode 3/A available"}}},
+						{Name: "MODE3A", Description: "Mode-3/A reply in octal representation", Offset: 4, Width: 12},
+					},
 				},
 				{
 					FRN:         18,
@@ -1042,6 +1596,11 @@ var Cat062V119 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "LENGTH", Description: "Length", Offset: 0, Width: 7, LSB: 1, Unit: "m"},
+				{Name: "ORIENTATION", Description: "Orientation", Offset: 8, Width: 7, LSB: 360.0 / 128, Unit: "deg"},
+				{Name: "WIDTH", Description: "Width", Offset: 16, Width: 7, LSB: 1, Unit: "m"},
+			},
 		},
 		{
 			FRN:         23,
@@ -1051,6 +1610,27 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "VFI", Description: "Vehicle fleet identification", Offset: 0, Width: 8, Values: []ValueTable{
+					{Value: 0, Meaning: "Unknown"},
+					{Value: 1, Meaning: "ATC equipment maintenance"},
+					{Value: 2, Meaning: "Airport maintenance"},
+					{Value: 3, Meaning: "Fire"},
+					{Value: 4, Meaning: "Bird scarer"},
+					{Value: 5, Meaning: "Snow plough"},
+					{Value: 6, Meaning: "Runway sweeper"},
+					{Value: 7, Meaning: "Emergency"},
+					{Value: 8, Meaning: "Police"},
+					{Value: 9, Meaning: "Bus"},
+					{Value: 10, Meaning: "Tug (push/tow)"},
+					{Value: 11, Meaning: "Grass cutter"},
+					{Value: 12, Meaning: "Fuel"},
+					{Value: 13, Meaning: "Baggage"},
+					{Value: 14, Meaning: "Catering"},
+					{Value: 15, Meaning: "Aircraft maintenance"},
+					{Value: 16, Meaning: "Flyco (follow me)"},
+				}},
+			},
 		},
 		{
 			FRN:         24,
@@ -1066,6 +1646,16 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "M5", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No Mode 5 interrogation"}, {Value: 1, Meaning: "Mode 5 interrogation"}}},
+						{Name: "ID", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No authenticated Mode 5 ID reply"}, {Value: 1, Meaning: "Authenticated Mode 5 ID reply"}}},
+						{Name: "DA", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "No authenticated Mode 5 Data reply or Report"}, {Value: 1, Meaning: "Authenticated Mode 5 Data reply or Report"}}},
+						{Name: "M1", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Mode 1 code not present or not from Mode 5 reply"}, {Value: 1, Meaning: "Mode 1 code from Mode 5 reply"}}},
+						{Name: "M2", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Mode 2 code not present or not from Mode 5 reply"}, {Value: 1, Meaning: "Mode 2 code from Mode 5 reply"}}},
+						{Name: "M3", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Mode 3 code not present or not from Mode 5 reply"}, {Value: 1, Meaning: "Mode 3 code from Mode 5 reply"}}},
+						{Name: "MC", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Flightlevel not present or not from Mode 5 reply"}, {Value: 1, Meaning: "Flightlevel from Mode 5 reply"}}},
+						{Name: "X", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "X-pulse set to zero or no authenticated Data reply or Report received"}, {Value: 1, Meaning: "X-pulse set to one"}}},
+					},
 				},
 				{
 					FRN:         2,
@@ -1075,6 +1665,11 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "PIN", Description: "Personal Identification Number", Offset: 2, Width: 14},
+						{Name: "NAT", Description: "National Origin", Offset: 19, Width: 5},
+						{Name: "MIS", Description: "Mission Code", Offset: 26, Width: 6},
+					},
 				},
 				{
 					FRN:         3,
@@ -1084,6 +1679,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 6,
 					},
+					Subfields: []Subfield{
+						{Name: "LAT", Description: "Latitude", Offset: 0, Width: 24, Signed: true, LSB: 180.0 / 8388608, Unit: "deg"},
+						{Name: "LON", Description: "Longitude", Offset: 24, Width: 24, Signed: true, LSB: 180.0 / 8388608, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         4,
@@ -1093,6 +1692,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "RES", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "GA reported in 100 ft increments"}, {Value: 1, Meaning: "GA reported in 25 ft increments"}}},
+						{Name: "GA", Description: "GNSS-derived altitude", Offset: 2, Width: 14, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         5,
@@ -1102,6 +1705,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "EM1", Description: "Extended Mode 1 reply in octal representation", Offset: 4, Width: 12},
+					},
 				},
 				{
 					FRN:         6,
@@ -1111,6 +1717,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TOS", Description: "Time offset for POS and GA", Offset: 0, Width: 8, Signed: true, LSB: 1.0 / 128, Unit: "s"},
+					},
 				},
 				{
 					FRN:         7,
@@ -1120,6 +1729,13 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "X5", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "X-pulse set to zero or no authenticated Data reply or Report received"}, {Value: 1, Meaning: "X-pulse set to one"}}},
+						{Name: "XC", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "X-pulse set to zero or no Mode C reply"}, {Value: 1, Meaning: "X-pulse set to one"}}},
+						{Name: "X3", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "X-pulse set to zero or no Mode 3/A reply"}, {Value: 1, Meaning: "X-pulse set to one"}}},
+						{Name: "X2", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "X-pulse set to zero or no Mode 2 reply"}, {Value: 1, Meaning: "X-pulse set to one"}}},
+						{Name: "X1", Offset: 7, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "X-pulse set to zero or no Mode 1 reply"}, {Value: 1, Meaning: "X-pulse set to one"}}},
+					},
 				},
 			},
 		},
@@ -1131,15 +1747,21 @@ var Cat062V119 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "MODE2", Description: "Mode-2 code in octal representation", Offset: 4, Width: 12},
+			},
 		},
 		{
 			FRN:         26,
 			DataItem:    "I062/510",
 			Description: "Composed Track Number",
-			Type:        Extended,
-			Extended: ExtendedField{
-				PrimarySize:   3,
-				SecondarySize: 3,
+			Type:        RepetitiveFX,
+			Repetitive: RepetitiveField{
+				SubItemSize: 3,
+			},
+			Subfields: []Subfield{
+				{Name: "IDENT", Description: "System unit identification", Offset: 0, Width: 8},
+				{Name: "TRACK", Description: "System track number", Offset: 8, Width: 15},
 			},
 		},
 		{
@@ -1156,6 +1778,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "APCX", Description: "Estimated accuracy of the track position (X)", Offset: 0, Width: 16, LSB: 0.5, Unit: "m"},
+						{Name: "APCY", Description: "Estimated accuracy of the track position (Y)", Offset: 16, Width: 16, LSB: 0.5, Unit: "m"},
+					},
 				},
 				{
 					FRN:         2,
@@ -1165,6 +1791,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "COV", Description: "XY covariance component", Offset: 0, Width: 16, Signed: true, LSB: 0.5, Unit: "m"},
+					},
 				},
 				{
 					FRN:         3,
@@ -1174,6 +1803,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "APWLAT", Description: "Estimated accuracy of the track position (latitude)", Offset: 0, Width: 16, LSB: 180.0 / 33554432, Unit: "deg"},
+						{Name: "APWLON", Description: "Estimated accuracy of the track position (longitude)", Offset: 16, Width: 16, LSB: 180.0 / 33554432, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         4,
@@ -1183,6 +1816,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "AGA", Description: "Estimated accuracy of the calculated track geometric altitude", Offset: 0, Width: 8, LSB: 6.25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         5,
@@ -1192,6 +1828,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "ABA", Description: "Estimated accuracy of the calculated track barometric altitude", Offset: 0, Width: 8, LSB: 0.25, Unit: "FL"},
+					},
 				},
 				{
 					FRN:         6,
@@ -1201,6 +1840,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "ATVX", Description: "Estimated accuracy of the track velocity (X)", Offset: 0, Width: 8, LSB: 0.25, Unit: "m/s"},
+						{Name: "ATVY", Description: "Estimated accuracy of the track velocity (Y)", Offset: 8, Width: 8, LSB: 0.25, Unit: "m/s"},
+					},
 				},
 				{
 					FRN:         7,
@@ -1210,6 +1853,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "AAX", Description: "Estimated accuracy of the acceleration (X)", Offset: 0, Width: 8, LSB: 0.25, Unit: "m/s2"},
+						{Name: "AAY", Description: "Estimated accuracy of the acceleration (Y)", Offset: 8, Width: 8, LSB: 0.25, Unit: "m/s2"},
+					},
 				},
 				// FX
 				{
@@ -1220,6 +1867,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "ARC", Description: "Estimated accuracy of the rate of climb/descent", Offset: 0, Width: 8, LSB: 6.25, Unit: "ft/min"},
+					},
 				},
 				{
 					FRN:  9,
@@ -1262,6 +1912,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+						{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+					},
 				},
 				{
 					FRN:         2,
@@ -1271,6 +1925,10 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 4,
 					},
+					Subfields: []Subfield{
+						{Name: "RHO", Description: "Measured distance", Offset: 0, Width: 16, LSB: 1.0 / 256, Unit: "NM"},
+						{Name: "THETA", Description: "Measured azimuth", Offset: 16, Width: 16, LSB: 360.0 / 65536, Unit: "deg"},
+					},
 				},
 				{
 					FRN:         3,
@@ -1280,6 +1938,9 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "HEI", Description: "Measured 3-D height", Offset: 0, Width: 16, Signed: true, LSB: 25, Unit: "ft"},
+					},
 				},
 				{
 					FRN:         4,
@@ -1289,6 +1950,11 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+						{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+						{Name: "LMC", Description: "Last measured Mode C code", Offset: 2, Width: 14, Signed: true, LSB: 0.25, Unit: "FL"},
+					},
 				},
 				{
 					FRN:         5,
@@ -1298,6 +1964,12 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 2,
 					},
+					Subfields: []Subfield{
+						{Name: "V", Offset: 0, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Code validated"}, {Value: 1, Meaning: "Code not validated"}}},
+						{Name: "G", Offset: 1, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Garbled code"}}},
+						{Name: "L", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Mode 3/A code as derived from the reply of the transponder"}, {Value: 1, Meaning: "Mode 3/A code as provided by a sensor track"}}},
+						{Name: "MODE3A", Description: "Mode-3/A reply in octal representation", Offset: 4, Width: 12},
+					},
 				},
 				{
 					FRN:         6,
@@ -1307,6 +1979,21 @@ var Cat062V119 = StandardUAP{
 					Fixed: FixedField{
 						Size: 1,
 					},
+					Subfields: []Subfield{
+						{Name: "TYP", Offset: 0, Width: 3, Values: []ValueTable{
+							{Value: 0, Meaning: "No detection"},
+							{Value: 1, Meaning: "Single PSR detection"},
+							{Value: 2, Meaning: "Single SSR detection"},
+							{Value: 3, Meaning: "SSR + PSR detection"},
+							{Value: 4, Meaning: "Single ModeS All-Call"},
+							{Value: 5, Meaning: "Single ModeS Roll-Call"},
+							{Value: 6, Meaning: "ModeS All-Call + PSR"},
+							{Value: 7, Meaning: "ModeS Roll-Call + PSR"},
+						}},
+						{Name: "SIM", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Actual target report"}, {Value: 1, Meaning: "Simulated target report"}}},
+						{Name: "RAB", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Report from target transponder"}, {Value: 1, Meaning: "Report from field monitor (fixed transponder)"}}},
+						{Name: "TST", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Real target report"}, {Value: 1, Meaning: "Test target report"}}},
+					},
 				},
 				{
 					FRN:  7,
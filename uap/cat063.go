@@ -6,6 +6,7 @@ var Cat063V16 = StandardUAP{
 	Name:     "cat063_1.6",
 	Category: 63,
 	Version:  1.6,
+	Edition:  Edition{Major: 1, Minor: 6},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -15,6 +16,10 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         2,
@@ -24,6 +29,9 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "SID", Description: "Service Identification", Offset: 0, Width: 8},
+			},
 		},
 		{
 			FRN:         3,
@@ -33,6 +41,9 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TOM", Description: "Time of Message", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         4,
@@ -42,6 +53,10 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         5,
@@ -52,6 +67,25 @@ var Cat063V16 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "CON", Offset: 0, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Operational"},
+					{Value: 1, Meaning: "Degraded"},
+					{Value: 2, Meaning: "Initialization"},
+					{Value: 3, Meaning: "Not currently connected"},
+				}},
+				{Name: "PSR", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "PSR GO"}, {Value: 1, Meaning: "PSR NOGO"}}},
+				{Name: "SSR", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "SSR GO"}, {Value: 1, Meaning: "SSR NOGO"}}},
+				{Name: "MDS", Offset: 4, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Mode S GO"}, {Value: 1, Meaning: "Mode S NOGO"}}},
+				{Name: "ADS", Offset: 5, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "ADS GO"}, {Value: 1, Meaning: "ADS NOGO"}}},
+				{Name: "MLT", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "MLT GO"}, {Value: 1, Meaning: "MLT NOGO"}}},
+				{Name: "OPS", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "System is released for operational use"}, {Value: 1, Meaning: "Operational use of System is inhibited"}}},
+				{Name: "ODP", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default, no overload"}, {Value: 1, Meaning: "Overload in DP"}}},
+				{Name: "OXT", Offset: 10, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default, no overload"}, {Value: 1, Meaning: "Overload in transmission subsystem"}}},
+				{Name: "MSC", Offset: 11, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Monitoring system connected"}, {Value: 1, Meaning: "Monitoring system disconnected"}}},
+				{Name: "TSV", Offset: 12, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Valid"}, {Value: 1, Meaning: "Invalid"}}},
+				{Name: "NPW", Offset: 13, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "No plots being received"}}},
+			},
 		},
 		{
 			FRN:         6,
@@ -61,6 +95,9 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "TSB", Description: "Time Stamping Bias", Offset: 0, Width: 16, Signed: true, LSB: 1, Unit: "ms"},
+			},
 		},
 		{
 			FRN:         7,
@@ -70,6 +107,10 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "SRG", Description: "SSR/Mode S range gain", Offset: 0, Width: 16, Signed: true, LSB: 0.00001},
+				{Name: "SRB", Description: "SSR/Mode S range bias", Offset: 16, Width: 16, Signed: true, LSB: 1.0 / 128, Unit: "NM"},
+			},
 		},
 		//FX : Field Extension Indicator
 		{
@@ -80,6 +121,9 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAB", Description: "SSR/Mode S azimuth bias", Offset: 0, Width: 16, Signed: true, LSB: 360.0 / 65536, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         9,
@@ -89,6 +133,10 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "PRG", Description: "PSR range gain", Offset: 0, Width: 16, Signed: true, LSB: 0.00001},
+				{Name: "PRB", Description: "PSR range bias", Offset: 16, Width: 16, Signed: true, LSB: 1.0 / 128, Unit: "NM"},
+			},
 		},
 		{
 			FRN:         10,
@@ -98,6 +146,9 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "PAB", Description: "PSR azimuth bias", Offset: 0, Width: 16, Signed: true, LSB: 360.0 / 65536, Unit: "deg"},
+			},
 		},
 		{
 			FRN:         11,
@@ -107,6 +158,9 @@ var Cat063V16 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "PEB", Description: "PSR elevation bias", Offset: 0, Width: 16, Signed: true, LSB: 360.0 / 65536, Unit: "deg"},
+			},
 		},
 		{
 			FRN:      12,
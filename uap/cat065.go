@@ -6,6 +6,7 @@ var Cat065V15 = StandardUAP{
 	Name:     "cat065_1.5",
 	Category: 65,
 	Version:  1.5,
+	Edition:  Edition{Major: 1, Minor: 5},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -15,6 +16,10 @@ var Cat065V15 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:         2,
@@ -24,6 +29,13 @@ var Cat065V15 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "MT", Description: "Message Type", Offset: 0, Width: 8, Values: []ValueTable{
+					{Value: 1, Meaning: "SDPS Status"},
+					{Value: 2, Meaning: "End of Batch"},
+					{Value: 3, Meaning: "Service Status Report"},
+				}},
+			},
 		},
 		{
 			FRN:         3,
@@ -33,6 +45,9 @@ var Cat065V15 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "SID", Description: "Service Identification", Offset: 0, Width: 8},
+			},
 		},
 		{
 			FRN:         4,
@@ -42,6 +57,9 @@ var Cat065V15 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "TOM", Description: "Time of Message", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:         5,
@@ -51,6 +69,9 @@ var Cat065V15 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "BTN", Description: "Batch Number", Offset: 0, Width: 8},
+			},
 		},
 		{
 			FRN:         6,
@@ -60,6 +81,23 @@ var Cat065V15 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "NOGO", Offset: 0, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Operational"},
+					{Value: 1, Meaning: "Degraded"},
+					{Value: 2, Meaning: "Not currently connected"},
+					{Value: 3, Meaning: "Unknown"},
+				}},
+				{Name: "OVL", Offset: 2, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Overload"}}},
+				{Name: "TSV", Offset: 3, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Invalid Time Source"}}},
+				{Name: "PSS", Offset: 4, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Not applicable"},
+					{Value: 1, Meaning: "SDPS-1 selected"},
+					{Value: 2, Meaning: "SDPS-2 selected"},
+					{Value: 3, Meaning: "SDPS-3 selected"},
+				}},
+				{Name: "STTN", Offset: 6, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Default"}, {Value: 1, Meaning: "Track re-numbering indication"}}},
+			},
 		},
 		{
 			FRN:         7,
@@ -69,6 +107,26 @@ var Cat065V15 = StandardUAP{
 			Fixed: FixedField{
 				Size: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "REPORT", Description: "Service Status Report", Offset: 0, Width: 8, Values: []ValueTable{
+					{Value: 1, Meaning: "Service degradation"},
+					{Value: 2, Meaning: "Service degradation ended"},
+					{Value: 3, Meaning: "Main radar out of service"},
+					{Value: 4, Meaning: "Service interrupted by the operator"},
+					{Value: 5, Meaning: "Service interrupted due to contingency"},
+					{Value: 6, Meaning: "Ready for service restart after contingency"},
+					{Value: 7, Meaning: "Service ended by the operator"},
+					{Value: 8, Meaning: "Failure of user main radar"},
+					{Value: 9, Meaning: "Service restarted by the operator"},
+					{Value: 10, Meaning: "Main radar becoming operational"},
+					{Value: 11, Meaning: "Main radar becoming degraded"},
+					{Value: 12, Meaning: "Service continuity interrupted due to disconnection with adjacent unit"},
+					{Value: 13, Meaning: "Service continuity restarted"},
+					{Value: 14, Meaning: "Service synchronised on backup radar"},
+					{Value: 15, Meaning: "Service synchronised on main radar"},
+					{Value: 16, Meaning: "Main and backup radar, if any, failed"},
+				}},
+			},
 		},
 		//FX : Field Extension Indicator
 		{
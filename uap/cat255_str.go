@@ -6,6 +6,7 @@ package uap
 var Cat255StrV51 = StandardUAP{
 	Category: 255,
 	Version:  5.1,
+	Edition:  Edition{Major: 5, Minor: 1},
 	Items: []DataField{
 		{
 			FRN:      1,
@@ -14,6 +15,10 @@ var Cat255StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 2,
 			},
+			Subfields: []Subfield{
+				{Name: "SAC", Description: "System Area Code", Offset: 0, Width: 8},
+				{Name: "SIC", Description: "System Identification Code", Offset: 8, Width: 8},
+			},
 		},
 		{
 			FRN:      2,
@@ -22,6 +27,9 @@ var Cat255StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 3,
 			},
+			Subfields: []Subfield{
+				{Name: "HEM", Description: "Heure d'émission du message", Offset: 0, Width: 24, LSB: 1.0 / 128, Unit: "s"},
+			},
 		},
 		{
 			FRN:      3,
@@ -31,6 +39,17 @@ var Cat255StrV51 = StandardUAP{
 				PrimarySize:   1,
 				SecondarySize: 1,
 			},
+			Subfields: []Subfield{
+				{Name: "VERSION", Description: "Numéro de la version logicielle en service", Offset: 0, Width: 3},
+				{Name: "NAP", Description: "Numéro du calculateur", Offset: 3, Width: 2},
+				{Name: "NS", Description: "Mode du serveur", Offset: 5, Width: 2, Values: []ValueTable{
+					{Value: 0, Meaning: "Principal"},
+					{Value: 1, Meaning: "Secours"},
+					{Value: 2, Meaning: "Test"},
+				}},
+				{Name: "ST", Description: "Statut du serveur", Offset: 8, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "Opérationnel"}, {Value: 1, Meaning: "Evaluation"}}},
+				{Name: "PS", Offset: 9, Width: 1, Values: []ValueTable{{Value: 0, Meaning: "STPV connecté au STR"}, {Value: 1, Meaning: "STPV déconnecté du STR"}}},
+			},
 		},
 		{
 			FRN:      4,
@@ -39,6 +58,10 @@ var Cat255StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 4,
 			},
+			Subfields: []Subfield{
+				{Name: "NIVINF", Description: "Niveau inférieur", Offset: 0, Width: 16, Signed: true},
+				{Name: "NIVSUP", Description: "Niveau supérieur", Offset: 16, Width: 16, Signed: true},
+			},
 		},
 		{
 			FRN:      5,
@@ -55,6 +78,12 @@ var Cat255StrV51 = StandardUAP{
 			Fixed: FixedField{
 				Size: 9,
 			},
+			Subfields: []Subfield{
+				{Name: "ORD", Description: "Ordre", Offset: 64, Width: 3, Values: []ValueTable{
+					{Value: 0, Meaning: "Activation de la carte"},
+					{Value: 1, Meaning: "Annulation de la carte"},
+				}},
+			},
 		},
 		{
 			FRN:      7,
@@ -6,6 +6,7 @@ var Cat4Test = StandardUAP{
 	Name:     "cat4test_0.1",
 	Category: 26, // not exist
 	Version:  0.1,
+	Edition:  Edition{Major: 0, Minor: 1},
 	Items: []DataField{
 		{
 			FRN:         1,
@@ -131,13 +132,20 @@ var Cat4Test = StandardUAP{
 			FRN:         10,
 			DataItem:    "I026/010",
 			Description: "Fixed type field for test",
-			Conditional: true,
 			Type:        Fixed,
 			Fixed: FixedField{
 				Size: 1,
 			},
 		},
 	},
+	Condition: &Condition{
+		FRN:  10,
+		Mask: 0x80,
+		Variants: []Variant{
+			{Value: 0x00, Items: Cat4TestPlot},
+			{Value: 0x80, Items: Cat4TestTrack},
+		},
+	},
 }
 
 var Cat4TestTrack = []DataField{
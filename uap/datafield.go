@@ -12,74 +12,113 @@ const (
 	RE
 	RFS
 	Spare
+	RepetitiveFX
 )
 
 // StandardUAP is User Application Profile
 // Cat is ASTERIX Category number (integer)
-// Version is ASTERIX version for a category
+// Version is ASTERIX version for a category as a float, kept for compatibility: use Edition
+// Edition is ASTERIX edition for a category, e.g. 1.30 is Major 1, Minor 30 (the float Version 1.3)
+// Condition is set when the category has several UAPs (e.g. plot and track for CAT001)
 type StandardUAP struct {
-	Name     string
-	Category uint8
-	Version  float64
-	Items    []DataField
+	Name      string      `json:"name" yaml:"name" xml:"name,attr"`
+	Category  uint8       `json:"category" yaml:"category" xml:"category,attr"`
+	Version   float64     `json:"version" yaml:"version" xml:"version,attr"`
+	Edition   Edition     `json:"edition" yaml:"edition" xml:"edition,attr"`
+	Items     []DataField `json:"items" yaml:"items" xml:"item"`
+	Condition *Condition  `json:"condition,omitempty" yaml:"condition,omitempty" xml:"condition,omitempty"`
+}
+
+// Condition describes a conditional UAP: the value of the discriminating item selects the remaining items.
+// FRN is the discriminating item, it must belong to Items.
+// Mask is applied to the first octet of the discriminating item, the result is compared to the Value of each variant.
+type Condition struct {
+	FRN      uint8     `json:"frn" yaml:"frn" xml:"frn,attr"`
+	Mask     uint8     `json:"mask" yaml:"mask" xml:"mask,attr"`
+	Variants []Variant `json:"variants" yaml:"variants" xml:"variant"`
+}
+
+// Variant is an alternative set of items of a conditional UAP, it follows the discriminating item.
+type Variant struct {
+	Value uint8       `json:"value" yaml:"value" xml:"value,attr"`
+	Items []DataField `json:"items" yaml:"items" xml:"item"`
+}
+
+// Select returns the items of the variant matching the first octet of the discriminating item.
+func (c Condition) Select(firstOctet byte) ([]DataField, bool) {
+	for _, v := range c.Variants {
+		if firstOctet&c.Mask == v.Value {
+			return v.Items, true
+		}
+	}
+	return nil, false
+}
+
+// EffectiveCondition returns the Condition of the profile. A profile without Condition whose data field is marked
+// with the deprecated DataField.Conditional selects the plot (Cat001PlotV12) or track (Cat001TrackV12) items
+// of CAT001 by the bit TYP of this data field, as before Condition, the same for the test category 26.
+func (s StandardUAP) EffectiveCondition() *Condition {
+	if s.Condition != nil {
+		return s.Condition
+	}
+	for _, f := range s.Items {
+		if !f.Conditional {
+			continue
+		}
+		switch s.Category {
+		case 1:
+			return &Condition{FRN: f.FRN, Mask: 0x80, Variants: []Variant{
+				{Value: 0x00, Items: Cat001PlotV12},
+				{Value: 0x80, Items: Cat001TrackV12},
+			}}
+		case 26:
+			return &Condition{FRN: f.FRN, Mask: 0x80, Variants: []Variant{
+				{Value: 0x00, Items: Cat4TestPlot},
+				{Value: 0x80, Items: Cat4TestTrack},
+			}}
+		}
+	}
+	return nil
 }
 
 // DataField describes FRN(Field Reference Number)
+// Subfields describe the bits of the data of the item, see Subfield.
 type DataField struct {
-	FRN         uint8
-	DataItem    string
-	Description string
-	Type        TypeField
-	Fixed       FixedField
-	Extended    ExtendedField
-	Repetitive  RepetitiveField
-	Explicit    ExplicitField
-	Compound    []DataField
-	Conditional bool
+	FRN         uint8           `json:"frn" yaml:"frn" xml:"frn,attr"`
+	DataItem    string          `json:"dataItem" yaml:"dataItem" xml:"dataItem,attr"`
+	Description string          `json:"description,omitempty" yaml:"description,omitempty" xml:"description,attr,omitempty"`
+	Type        TypeField       `json:"type" yaml:"type" xml:"type,attr"`
+	Fixed       FixedField      `json:"fixed" yaml:"fixed,omitempty" xml:"fixed"`
+	Extended    ExtendedField   `json:"extended" yaml:"extended,omitempty" xml:"extended"`
+	Repetitive  RepetitiveField `json:"repetitive" yaml:"repetitive,omitempty" xml:"repetitive"`
+	Explicit    ExplicitField   `json:"explicit" yaml:"explicit,omitempty" xml:"explicit"`
+	Compound    []DataField     `json:"compound,omitempty" yaml:"compound,omitempty" xml:"compound>item,omitempty"`
+	Subfields   []Subfield      `json:"subfields,omitempty" yaml:"subfields,omitempty" xml:"subfield,omitempty"`
+
+	// Deprecated: Conditional marks the discriminating item of the plot and track UAPs of CAT001 in a profile
+	// without Condition, use StandardUAP.Condition instead. See StandardUAP.EffectiveCondition.
+	Conditional bool `json:"conditional,omitempty" yaml:"conditional,omitempty" xml:"conditional,attr,omitempty"`
 }
 type FixedField struct {
-	Size uint8
+	Size uint8 `json:"size" yaml:"size" xml:"size,attr"`
 }
 type ExtendedField struct {
-	PrimarySize   uint8
-	SecondarySize uint8
-}
-type RepetitiveField struct {
-	SubItemSize uint8
-}
-type ExplicitField struct {
+	PrimarySize   uint8 `json:"primarySize" yaml:"primarySize" xml:"primarySize,attr"`
+	SecondarySize uint8 `json:"secondarySize" yaml:"secondarySize" xml:"secondarySize,attr"`
 }
 
-/*
-type TypeField struct {
-	NameType TypeField
-	Size     uint8
-	PrimarySize   uint8    // used for extended
-	SecondarySize uint8    // used for extended
-	Primary       *Primary // used for compound
+// RepetitiveField describes the sub-item repeated REP times.
+// When Compound is set, each repetition is a compound of these sub-items (e.g. a group of variable length)
+// and SubItemSize is not used.
+// For a RepetitiveFX data field, SubItemSize is the size of each repetition, the last bit of which is the FX bit
+// indicating that another repetition follows.
+type RepetitiveField struct {
+	SubItemSize uint8       `json:"subItemSize" yaml:"subItemSize" xml:"subItemSize,attr"`
+	Compound    []DataField `json:"compound,omitempty" yaml:"compound,omitempty" xml:"compound>item,omitempty"`
 }
 
-// Bit is a bit number position
-type Bit uint8
-
-// Size is the number of bytes (size) of the corresponding field
-type Size uint8
-
-// Primary subitem, followed by data subitems.
-// The Compound Data Item primary subitem determines the presence or absence of the subsequent
-// data subitems and is made of a first part of one octet extendible using the Field Extension (FX) mechanism
-type Primary []MetaField
-
-// MetaField contains a hashmap [number of bit key] <=> [type of field and size]
-type MetaField map[Bit]Subfield
-
-// Subfield describes the type of field and the size if necessary
-type Subfield struct {
-	NameType      TypeField
-	Size          uint8
-	PrimarySize   uint8
-	SecondarySize uint8
-	Item          string
-	Description   string
+// ExplicitField describes the contents following the length indicator.
+// When Compound is set, the contents is a compound of these sub-items, otherwise it is opaque.
+type ExplicitField struct {
+	Compound []DataField `json:"compound,omitempty" yaml:"compound,omitempty" xml:"compound>item,omitempty"`
 }
-*/
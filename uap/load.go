@@ -0,0 +1,247 @@
+package uap
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrUAPInvalid reports that a User Application Profile is inconsistent and can not be used for decoding.
+	ErrUAPInvalid = errors.New("[ASTERIX] UAP invalid")
+
+	// ErrFormatUnknown reports that the format of a UAP file is not JSON, YAML or XML.
+	ErrFormatUnknown = errors.New("[ASTERIX] UAP format unknown")
+
+	// ErrTypeFieldUnknown reports that the type of a data field is not a TypeField name.
+	ErrTypeFieldUnknown = errors.New("[ASTERIX] type of data field unknown")
+)
+
+// typeFieldNames are the names of the TypeField in the UAP files, indexed by TypeField.
+var typeFieldNames = [...]string{
+	Fixed:        "fixed",
+	Extended:     "extended",
+	Compound:     "compound",
+	Repetitive:   "repetitive",
+	Explicit:     "explicit",
+	SP:           "sp",
+	RE:           "re",
+	RFS:          "rfs",
+	Spare:        "spare",
+	RepetitiveFX: "repetitivefx",
+}
+
+// String returns the name of the TypeField, e.g. "fixed", "repetitivefx".
+func (t TypeField) String() string {
+	if int(t) < len(typeFieldNames) && typeFieldNames[t] != "" {
+		return typeFieldNames[t]
+	}
+	return fmt.Sprintf("TypeField(%d)", uint8(t))
+}
+
+// MarshalText returns the name of the TypeField, it is used by the JSON, YAML and XML encodings.
+func (t TypeField) MarshalText() ([]byte, error) {
+	if int(t) >= len(typeFieldNames) || typeFieldNames[t] == "" {
+		return nil, ErrTypeFieldUnknown
+	}
+	return []byte(typeFieldNames[t]), nil
+}
+
+// UnmarshalText sets the TypeField from its name, the case is ignored.
+func (t *TypeField) UnmarshalText(text []byte) error {
+	name := strings.ToLower(string(text))
+	for i, n := range typeFieldNames {
+		if n != "" && n == name {
+			*t = TypeField(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrTypeFieldUnknown, text)
+}
+
+// ReadJSON returns the User Application Profile read from r in JSON and validated.
+// Unknown fields are rejected to detect the typing errors of a profile.
+func ReadJSON(r io.Reader) (StandardUAP, error) {
+	var stdUAP StandardUAP
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&stdUAP); err != nil {
+		return StandardUAP{}, err
+	}
+	stdUAP.Edition = stdUAP.edition()
+	return stdUAP, stdUAP.Validate()
+}
+
+// ReadYAML returns the User Application Profile read from r in YAML and validated.
+// Unknown fields are rejected to detect the typing errors of a profile.
+func ReadYAML(r io.Reader) (StandardUAP, error) {
+	var stdUAP StandardUAP
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&stdUAP); err != nil {
+		return StandardUAP{}, err
+	}
+	stdUAP.Edition = stdUAP.edition()
+	return stdUAP, stdUAP.Validate()
+}
+
+// ReadXML returns the User Application Profile read from r in XML and validated.
+func ReadXML(r io.Reader) (StandardUAP, error) {
+	var stdUAP StandardUAP
+	if err := xml.NewDecoder(r).Decode(&stdUAP); err != nil {
+		return StandardUAP{}, err
+	}
+	stdUAP.Edition = stdUAP.edition()
+	return stdUAP, stdUAP.Validate()
+}
+
+// LoadFile returns the User Application Profile read from the file and validated.
+// The format is given by the extension of the file: ".json", ".yaml", ".yml" or ".xml".
+func LoadFile(path string) (StandardUAP, error) {
+	var read func(io.Reader) (StandardUAP, error)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		read = ReadJSON
+	case ".yaml", ".yml":
+		read = ReadYAML
+	case ".xml":
+		read = ReadXML
+	default:
+		return StandardUAP{}, fmt.Errorf("%w: %s", ErrFormatUnknown, path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return StandardUAP{}, err
+	}
+	defer f.Close()
+
+	stdUAP, err := read(f)
+	if err != nil {
+		return StandardUAP{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return stdUAP, nil
+}
+
+// Validate returns an error wrapping ErrUAPInvalid when the profile can not be used for decoding:
+// the data fields must be ordered by FRN from 1 (the FRN is the index of the data field),
+// have a known type and the sizes needed by their type, the same for the sub-items of a compound from FRN 1.
+// The discriminating item of a condition must be Fixed or Extended and its variants follow it.
+func (s StandardUAP) Validate() error {
+	if len(s.Items) == 0 {
+		return fmt.Errorf("%w: category %d has no items", ErrUAPInvalid, s.Category)
+	}
+	if err := validateFields(s.Items, 1); err != nil {
+		return fmt.Errorf("%w: category %d, %v", ErrUAPInvalid, s.Category, err)
+	}
+	c := s.EffectiveCondition()
+	if c == nil {
+		return nil
+	}
+
+	if c.FRN == 0 || int(c.FRN) > len(s.Items) {
+		return fmt.Errorf("%w: category %d, condition FRN %d not in items", ErrUAPInvalid, s.Category, c.FRN)
+	}
+	if t := s.Items[c.FRN-1].Type; t != Fixed && t != Extended {
+		return fmt.Errorf("%w: category %d, condition FRN %d is %s", ErrUAPInvalid, s.Category, c.FRN, t)
+	}
+	if len(c.Variants) == 0 {
+		return fmt.Errorf("%w: category %d, condition has no variants", ErrUAPInvalid, s.Category)
+	}
+	for _, v := range c.Variants {
+		if err := validateFields(v.Items, c.FRN+1); err != nil {
+			return fmt.Errorf("%w: category %d, variant %d, %v", ErrUAPInvalid, s.Category, v.Value, err)
+		}
+	}
+	return nil
+}
+
+// validateFields checks the data fields numbered from the FRN first and their sub-items.
+func validateFields(fields []DataField, first uint8) error {
+	for i, f := range fields {
+		if int(f.FRN) != int(first)+i {
+			return fmt.Errorf("data field %d (%s): FRN %d, expected %d", i+1, f.DataItem, f.FRN, int(first)+i)
+		}
+		if err := validateField(f); err != nil {
+			return fmt.Errorf("FRN %d (%s): %v", f.FRN, f.DataItem, err)
+		}
+	}
+	return nil
+}
+
+// validateField checks the sizes and the sub-items needed by the type of the data field and its sub-fields.
+func validateField(f DataField) error {
+	if err := validateSubfields(f); err != nil {
+		return err
+	}
+	switch f.Type {
+	case Fixed:
+		if f.Fixed.Size == 0 {
+			return errors.New("fixed size 0")
+		}
+	case Extended:
+		if f.Extended.PrimarySize == 0 || f.Extended.SecondarySize == 0 {
+			return errors.New("extended size 0")
+		}
+	case Repetitive:
+		if f.Repetitive.Compound != nil {
+			return validateFields(f.Repetitive.Compound, 1)
+		}
+		if f.Repetitive.SubItemSize == 0 {
+			return errors.New("repetitive size 0")
+		}
+	case RepetitiveFX:
+		if f.Repetitive.SubItemSize == 0 {
+			return errors.New("repetitivefx size 0")
+		}
+	case Explicit:
+		if f.Explicit.Compound != nil {
+			return validateFields(f.Explicit.Compound, 1)
+		}
+	case Compound:
+		if len(f.Compound) == 0 {
+			return errors.New("compound without sub-items")
+		}
+		return validateFields(f.Compound, 1)
+	case SP, RE, RFS, Spare:
+	default:
+		return fmt.Errorf("%w: %d", ErrTypeFieldUnknown, uint8(f.Type))
+	}
+	return nil
+}
+
+// validateSubfields checks that the sub-fields are within the data of the data field.
+func validateSubfields(f DataField) error {
+	if len(f.Subfields) == 0 {
+		return nil
+	}
+	size := -1 // the data of Extended and Explicit items has a variable size
+	switch f.Type {
+	case Fixed:
+		size = int(f.Fixed.Size)
+	case Repetitive, RepetitiveFX:
+		if f.Repetitive.Compound != nil {
+			return errors.New("subfields of a repetitive compound")
+		}
+		size = int(f.Repetitive.SubItemSize)
+	case Compound, RFS, Spare:
+		return fmt.Errorf("subfields of a %s item", f.Type)
+	}
+	for _, sub := range f.Subfields {
+		valid := sub.Width != 0 && sub.Width <= 64 && sub.lsbValid()
+		if size >= 0 {
+			valid = valid && sub.fits(size)
+		}
+		if !valid {
+			return fmt.Errorf("subfield %s: offset %d, width %d, lsb %v", sub.Name, sub.Offset, sub.Width, sub.LSB)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,370 @@
+package uap
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// uapLoadTest is the profile described by the files of the tests.
+var uapLoadTest = StandardUAP{
+	Name:     "cat026_test",
+	Category: 26,
+	Version:  1.3,
+	Edition:  Edition{Major: 1, Minor: 30},
+	Items: []DataField{
+		{FRN: 1, DataItem: "I026/010", Description: "Data Source Identifier", Type: Fixed, Fixed: FixedField{Size: 2}},
+		{FRN: 2, DataItem: "I026/020", Description: "Target Report Descriptor", Type: Extended,
+			Extended: ExtendedField{PrimarySize: 1, SecondarySize: 1}},
+		{FRN: 3, DataItem: "I026/030", Type: Compound, Compound: []DataField{
+			{FRN: 1, DataItem: "SRL", Type: Fixed, Fixed: FixedField{Size: 1}},
+			{FRN: 2, DataItem: "TRK", Type: RepetitiveFX, Repetitive: RepetitiveField{SubItemSize: 3}},
+		}},
+		{FRN: 4, DataItem: "SP", Type: SP},
+	},
+	Condition: &Condition{
+		FRN:  2,
+		Mask: 0x80,
+		Variants: []Variant{
+			{Value: 0x00, Items: []DataField{{FRN: 3, DataItem: "I026/040", Type: Fixed, Fixed: FixedField{Size: 1}}}},
+		},
+	},
+}
+
+const uapLoadTestJSON = `{
+	"name": "cat026_test", "category": 26, "version": 1.3, "edition": "1.30",
+	"items": [
+		{"frn": 1, "dataItem": "I026/010", "description": "Data Source Identifier", "type": "fixed", "fixed": {"size": 2}},
+		{"frn": 2, "dataItem": "I026/020", "description": "Target Report Descriptor", "type": "extended",
+			"extended": {"primarySize": 1, "secondarySize": 1}},
+		{"frn": 3, "dataItem": "I026/030", "type": "compound", "compound": [
+			{"frn": 1, "dataItem": "SRL", "type": "fixed", "fixed": {"size": 1}},
+			{"frn": 2, "dataItem": "TRK", "type": "repetitivefx", "repetitive": {"subItemSize": 3}}
+		]},
+		{"frn": 4, "dataItem": "SP", "type": "SP"}
+	],
+	"condition": {"frn": 2, "mask": 128, "variants": [
+		{"value": 0, "items": [{"frn": 3, "dataItem": "I026/040", "type": "fixed", "fixed": {"size": 1}}]}
+	]}
+}`
+
+const uapLoadTestYAML = `
+name: cat026_test
+category: 26
+version: 1.3
+edition: 1.30
+items:
+  - {frn: 1, dataItem: I026/010, description: Data Source Identifier, type: fixed, fixed: {size: 2}}
+  - frn: 2
+    dataItem: I026/020
+    description: Target Report Descriptor
+    type: extended
+    extended: {primarySize: 1, secondarySize: 1}
+  - frn: 3
+    dataItem: I026/030
+    type: compound
+    compound:
+      - {frn: 1, dataItem: SRL, type: fixed, fixed: {size: 1}}
+      - {frn: 2, dataItem: TRK, type: repetitivefx, repetitive: {subItemSize: 3}}
+  - {frn: 4, dataItem: SP, type: sp}
+condition:
+  frn: 2
+  mask: 0x80
+  variants:
+    - value: 0
+      items:
+        - {frn: 3, dataItem: I026/040, type: fixed, fixed: {size: 1}}
+`
+
+const uapLoadTestXML = `
+<StandardUAP name="cat026_test" category="26" version="1.3" edition="1.30">
+	<item frn="1" dataItem="I026/010" description="Data Source Identifier" type="fixed"><fixed size="2"/></item>
+	<item frn="2" dataItem="I026/020" description="Target Report Descriptor" type="extended">
+		<extended primarySize="1" secondarySize="1"/>
+	</item>
+	<item frn="3" dataItem="I026/030" type="compound">
+		<compound>
+			<item frn="1" dataItem="SRL" type="fixed"><fixed size="1"/></item>
+			<item frn="2" dataItem="TRK" type="repetitivefx"><repetitive subItemSize="3"/></item>
+		</compound>
+	</item>
+	<item frn="4" dataItem="SP" type="sp"/>
+	<condition frn="2" mask="128">
+		<variant value="0">
+			<item frn="3" dataItem="I026/040" type="fixed"><fixed size="1"/></item>
+		</variant>
+	</condition>
+</StandardUAP>`
+
+func TestRead(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name  string
+		input string
+		read  func(r *strings.Reader) (StandardUAP, error)
+	}
+	dataSet := []testCase{
+		{
+			Name:  "testcase 1: JSON",
+			input: uapLoadTestJSON,
+			read:  func(r *strings.Reader) (StandardUAP, error) { return ReadJSON(r) },
+		},
+		{
+			Name:  "testcase 2: YAML",
+			input: uapLoadTestYAML,
+			read:  func(r *strings.Reader) (StandardUAP, error) { return ReadYAML(r) },
+		},
+		{
+			Name:  "testcase 3: XML",
+			input: uapLoadTestXML,
+			read:  func(r *strings.Reader) (StandardUAP, error) { return ReadXML(r) },
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		r := strings.NewReader(tc.input)
+
+		// Act
+		stdUAP, err := tc.read(r)
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+		if !reflect.DeepEqual(stdUAP, uapLoadTest) {
+			t.Errorf("FAIL: %s - uap = %+v; Expected: %+v", tc.Name, stdUAP, uapLoadTest)
+		} else {
+			t.Logf("SUCCESS: %s - uap = %v; Expected: %v", tc.Name, stdUAP.Name, uapLoadTest.Name)
+		}
+	}
+}
+
+func TestRead_RoundTrip(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name    string
+		marshal func(v interface{}) ([]byte, error)
+		read    func(r *bytes.Reader) (StandardUAP, error)
+	}
+	dataSet := []testCase{
+		{
+			Name:    "testcase 1: JSON",
+			marshal: json.Marshal,
+			read:    func(r *bytes.Reader) (StandardUAP, error) { return ReadJSON(r) },
+		},
+		{
+			Name:    "testcase 2: YAML",
+			marshal: yaml.Marshal,
+			read:    func(r *bytes.Reader) (StandardUAP, error) { return ReadYAML(r) },
+		},
+		{
+			Name:    "testcase 3: XML",
+			marshal: xml.Marshal,
+			read:    func(r *bytes.Reader) (StandardUAP, error) { return ReadXML(r) },
+		},
+	}
+
+	for _, tc := range dataSet {
+		for _, input := range []StandardUAP{Cat001V12, Cat048V127, Cat062V119, Cat4Test} {
+			// Arrange
+			data, err := tc.marshal(input)
+			if err != nil {
+				t.Fatalf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+			}
+
+			// Act
+			stdUAP, err := tc.read(bytes.NewReader(data))
+
+			// Assert
+			if err != nil || !reflect.DeepEqual(stdUAP, input) {
+				t.Errorf("FAIL: %s - uap = %v, err = %v; Expected: %v, %v", tc.Name, stdUAP.Name, err, input.Name, nil)
+			} else {
+				t.Logf("SUCCESS: %s - uap = %v, err = %v; Expected: %v, %v", tc.Name, stdUAP.Name, err, input.Name, nil)
+			}
+		}
+	}
+}
+
+func TestStandardUAP_Validate(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name  string
+		input string
+		err   error
+	}
+	dataSet := []testCase{
+		{
+			Name:  "testcase 1: valid",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/010", "type": "fixed", "fixed": {"size": 2}}]}`,
+			err:   nil,
+		},
+		{
+			Name:  "testcase 2: no items",
+			input: `{"category": 26, "items": []}`,
+			err:   ErrUAPInvalid,
+		},
+		{
+			Name:  "testcase 3: FRN out of order",
+			input: `{"category": 26, "items": [{"frn": 2, "dataItem": "I026/010", "type": "fixed", "fixed": {"size": 2}}]}`,
+			err:   ErrUAPInvalid,
+		},
+		{
+			Name:  "testcase 4: fixed size 0",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/010", "type": "fixed"}]}`,
+			err:   ErrUAPInvalid,
+		},
+		{
+			Name:  "testcase 5: extended size 0",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/020", "type": "extended"}]}`,
+			err:   ErrUAPInvalid,
+		},
+		{
+			Name:  "testcase 6: compound without sub-items",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/030", "type": "compound"}]}`,
+			err:   ErrUAPInvalid,
+		},
+		{
+			Name: "testcase 7: sub-item invalid",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/030", "type": "compound",
+				"compound": [{"frn": 1, "dataItem": "TRK", "type": "repetitivefx"}]}]}`,
+			err: ErrUAPInvalid,
+		},
+		{
+			Name: "testcase 8: condition on a compound",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/030", "type": "compound",
+				"compound": [{"frn": 1, "dataItem": "SRL", "type": "fixed", "fixed": {"size": 1}}]}],
+				"condition": {"frn": 1, "variants": [{"value": 0, "items": []}]}}`,
+			err: ErrUAPInvalid,
+		},
+		{
+			Name: "testcase 9: subfield beyond the fixed size",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/010", "type": "fixed", "fixed": {"size": 2},
+				"subfields": [{"name": "SAC", "offset": 8, "width": 16}]}]}`,
+			err: ErrUAPInvalid,
+		},
+		{
+			Name: "testcase 10: subfield of a compound",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/030", "type": "compound",
+				"compound": [{"frn": 1, "dataItem": "SRL", "type": "fixed", "fixed": {"size": 1}}],
+				"subfields": [{"name": "SRL", "offset": 0, "width": 8}]}]}`,
+			err: ErrUAPInvalid,
+		},
+		{
+			Name:  "testcase 11: type unknown",
+			input: `{"category": 26, "items": [{"frn": 1, "dataItem": "I026/010", "type": "bitfield"}]}`,
+			err:   ErrTypeFieldUnknown,
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		r := strings.NewReader(tc.input)
+
+		// Act
+		_, err := ReadJSON(r)
+
+		// Assert
+		if !errors.Is(err, tc.err) {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		}
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name  string
+		file  string
+		input string
+		err   error
+	}
+	dataSet := []testCase{
+		{Name: "testcase 1: JSON", file: "cat026.json", input: uapLoadTestJSON, err: nil},
+		{Name: "testcase 2: YAML", file: "cat026.yml", input: uapLoadTestYAML, err: nil},
+		{Name: "testcase 3: XML", file: "cat026.xml", input: uapLoadTestXML, err: nil},
+		{Name: "testcase 4: format unknown", file: "cat026.txt", input: uapLoadTestJSON, err: ErrFormatUnknown},
+	}
+	dir := t.TempDir()
+
+	for _, tc := range dataSet {
+		// Arrange
+		path := filepath.Join(dir, tc.file)
+		if err := os.WriteFile(path, []byte(tc.input), 0600); err != nil {
+			t.Fatalf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+
+		// Act
+		stdUAP, err := LoadFile(path)
+
+		// Assert
+		switch {
+		case !errors.Is(err, tc.err):
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		case tc.err == nil && !reflect.DeepEqual(stdUAP, uapLoadTest):
+			t.Errorf("FAIL: %s - uap = %+v; Expected: %+v", tc.Name, stdUAP, uapLoadTest)
+		default:
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		}
+	}
+}
+
+func TestRead_UnknownField(t *testing.T) {
+	// Arrange
+	inputJSON := strings.NewReader(`{"name": "cat026_test", "categorie": 26}`)
+	inputYAML := strings.NewReader("name: cat026_test\ncategorie: 26\n")
+
+	// Act
+	_, errJSON := ReadJSON(inputJSON)
+	_, errYAML := ReadYAML(inputYAML)
+
+	// Assert
+	if errJSON == nil || errYAML == nil {
+		t.Errorf("FAIL: err = %v, %v; Expected: unknown field errors", errJSON, errYAML)
+	} else {
+		t.Logf("SUCCESS: err = %v, %v; Expected: unknown field errors", errJSON, errYAML)
+	}
+}
+
+func TestStandardUAP_ValidateBuiltIn(t *testing.T) {
+	// setup
+	type dataTest struct {
+		Name   string
+		stdUAP StandardUAP
+	}
+	var dataSet []dataTest
+	for cat, stdUAP := range DefaultProfiles {
+		dataSet = append(dataSet, dataTest{Name: fmt.Sprintf("DefaultProfiles[%d] %s", cat, stdUAP.Name), stdUAP: stdUAP})
+	}
+	for cat := 0; cat <= 0xFF; cat++ {
+		for _, e := range DefaultRegistry.Editions(uint8(cat)) {
+			stdUAP, _ := DefaultRegistry.Lookup(uint8(cat), e)
+			dataSet = append(dataSet, dataTest{Name: fmt.Sprintf("DefaultRegistry category %d edition %s", cat, e), stdUAP: stdUAP})
+		}
+	}
+
+	for _, tc := range dataSet {
+		// Act
+		err := tc.stdUAP.Validate()
+
+		// Assert
+		if err != nil {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, nil)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package uap
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrEditionUnknown reports that no User Application Profile is registered for an edition of a category.
+var ErrEditionUnknown = errors.New("[ASTERIX] UAP edition unknown")
+
+// Edition is the edition of a category, e.g. 1.27 is Major 1, Minor 27.
+// Editions are ordered by Major then Minor: 1.5 is before 1.27, unlike the float Version 1.5 > 1.27.
+type Edition struct {
+	Major uint8
+	Minor uint8
+}
+
+// ParseEdition returns the edition written as "major.minor" (e.g. "1.27") or "major" (e.g. "7").
+func ParseEdition(s string) (Edition, error) {
+	parts := strings.SplitN(s, ".", 2)
+	major, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return Edition{}, fmt.Errorf("%w: %q", ErrEditionUnknown, s)
+	}
+	var minor uint64
+	if len(parts) == 2 {
+		minor, err = strconv.ParseUint(parts[1], 10, 8)
+		if err != nil {
+			return Edition{}, fmt.Errorf("%w: %q", ErrEditionUnknown, s)
+		}
+	}
+	return Edition{Major: uint8(major), Minor: uint8(minor)}, nil
+}
+
+func (e Edition) String() string {
+	return fmt.Sprintf("%d.%d", e.Major, e.Minor)
+}
+
+// MarshalText returns the edition written as "major.minor", it is used by the JSON, YAML and XML encodings.
+func (e Edition) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText sets the edition written as "major.minor" or "major", see ParseEdition.
+func (e *Edition) UnmarshalText(text []byte) error {
+	edition, err := ParseEdition(string(text))
+	if err != nil {
+		return err
+	}
+	*e = edition
+	return nil
+}
+
+// Less returns true if e is before o.
+func (e Edition) Less(o Edition) bool {
+	return e.Major < o.Major || (e.Major == o.Major && e.Minor < o.Minor)
+}
+
+// edition returns the Edition of the profile. A profile without Edition, e.g. written before its addition,
+// has the edition of its Version written with its shortest decimal representation: 1.27 is 1.27 and 1.2 is 1.2,
+// an edition 1.20 needs its Edition.
+func (s StandardUAP) edition() Edition {
+	if s.Edition != (Edition{}) {
+		return s.Edition
+	}
+	e, _ := ParseEdition(strconv.FormatFloat(s.Version, 'f', -1, 64))
+	return e
+}
+
+// Registry contains several editions of the User Application Profiles of each category.
+// The profile used for a category is the edition pinned by Pin, or the latest one registered.
+// A Registry is safe for concurrent use by multiple goroutines.
+type Registry struct {
+	mu       sync.RWMutex
+	profiles map[uint8][]StandardUAP // sorted by edition
+	pinned   map[uint8]Edition
+}
+
+// NewRegistry returns a Registry containing the profiles, see Register.
+func NewRegistry(profiles ...StandardUAP) *Registry {
+	r := &Registry{
+		profiles: make(map[uint8][]StandardUAP),
+		pinned:   make(map[uint8]Edition),
+	}
+	for _, stdUAP := range profiles {
+		r.Register(stdUAP)
+	}
+	return r
+}
+
+// DefaultRegistry contains the profiles of DefaultProfiles, pinned as the profile of their category,
+// and the other editions defined by this package, e.g. Cat030ArtasV62 and Cat030ArtasV70 for CAT030.
+// It is built from DefaultProfiles at initialization, a later change of DefaultProfiles is not registered.
+var DefaultRegistry = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry(Cat030ArtasV62, Cat030ArtasV70)
+	for _, stdUAP := range DefaultProfiles {
+		r.Register(stdUAP)
+		_ = r.Pin(stdUAP.Category, stdUAP.edition())
+	}
+	return r
+}
+
+// Register adds stdUAP as the edition stdUAP.Edition of its category and replaces the previous profile
+// of this edition.
+func (r *Registry) Register(stdUAP StandardUAP) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	editions := r.profiles[stdUAP.Category]
+	e := stdUAP.edition()
+	i := sort.Search(len(editions), func(i int) bool { return !editions[i].edition().Less(e) })
+	if i < len(editions) && editions[i].edition() == e {
+		editions[i] = stdUAP
+		return
+	}
+	editions = append(editions, StandardUAP{})
+	copy(editions[i+1:], editions[i:])
+	editions[i] = stdUAP
+	r.profiles[stdUAP.Category] = editions
+}
+
+// LoadFile registers the profile read from the file (JSON, YAML or XML, see LoadFile),
+// e.g. the profile of an edition still used by some sensors.
+func (r *Registry) LoadFile(path string) error {
+	stdUAP, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+	r.Register(stdUAP)
+	return nil
+}
+
+// Lookup returns the profile of the edition of the category.
+func (r *Registry) Lookup(category uint8, edition Edition) (StandardUAP, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lookup(category, edition)
+}
+
+func (r *Registry) lookup(category uint8, edition Edition) (StandardUAP, bool) {
+	for _, stdUAP := range r.profiles[category] {
+		if stdUAP.edition() == edition {
+			return stdUAP, true
+		}
+	}
+	return StandardUAP{}, false
+}
+
+// Latest returns the profile of the latest edition of the category.
+func (r *Registry) Latest(category uint8) (StandardUAP, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	editions := r.profiles[category]
+	if len(editions) == 0 {
+		return StandardUAP{}, false
+	}
+	return editions[len(editions)-1], true
+}
+
+// Editions returns the editions registered for the category from the oldest to the latest.
+func (r *Registry) Editions(category uint8) []Edition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var editions []Edition
+	for _, stdUAP := range r.profiles[category] {
+		editions = append(editions, stdUAP.edition())
+	}
+	return editions
+}
+
+// Pin selects the edition as the profile of the category instead of the latest one.
+// It changes the profiles of the decoders created afterwards from the registry (goasterix.NewDecoder for
+// DefaultRegistry, goasterix.NewDecoderFromRegistry), not those of the existing decoders, nor DefaultProfiles
+// used by goasterix.DataBlock.Decode.
+// It returns an error wrapping ErrEditionUnknown when the edition is not registered.
+func (r *Registry) Pin(category uint8, edition Edition) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, found := r.lookup(category, edition); !found {
+		return fmt.Errorf("%w: category %d edition %s", ErrEditionUnknown, category, edition)
+	}
+	r.pinned[category] = edition
+	return nil
+}
+
+// Unpin selects the latest edition as the profile of the category.
+func (r *Registry) Unpin(category uint8) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.pinned, category)
+}
+
+// Profile returns the profile of the category: its pinned edition, or its latest edition.
+func (r *Registry) Profile(category uint8) (StandardUAP, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.profile(category)
+}
+
+func (r *Registry) profile(category uint8) (StandardUAP, bool) {
+	if e, pinned := r.pinned[category]; pinned {
+		return r.lookup(category, e)
+	}
+	editions := r.profiles[category]
+	if len(editions) == 0 {
+		return StandardUAP{}, false
+	}
+	return editions[len(editions)-1], true
+}
+
+// Profiles returns the profile of each category, like Profile.
+// The result can be used like DefaultProfiles, e.g. to seed a goasterix.Decoder.
+func (r *Registry) Profiles() map[uint8]StandardUAP {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	profiles := make(map[uint8]StandardUAP, len(r.profiles))
+	for category := range r.profiles {
+		if stdUAP, found := r.profile(category); found {
+			profiles[category] = stdUAP
+		}
+	}
+	return profiles
+}
@@ -0,0 +1,174 @@
+package uap
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// editionUAP returns a profile of the category of CAT4Test whose edition is major.minor, for the tests of the registry.
+func editionUAP(major uint8, minor uint8) StandardUAP {
+	stdUAP := Cat4Test
+	stdUAP.Edition = Edition{Major: major, Minor: minor}
+	return stdUAP
+}
+
+func TestParseEdition(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name    string
+		input   string
+		edition Edition
+		err     error
+	}
+	dataSet := []testCase{
+		{Name: "testcase 1: major and minor", input: "1.27", edition: Edition{Major: 1, Minor: 27}, err: nil},
+		{Name: "testcase 2: major only", input: "7", edition: Edition{Major: 7, Minor: 0}, err: nil},
+		{Name: "testcase 3: not a number", input: "v1.2", edition: Edition{}, err: ErrEditionUnknown},
+		{Name: "testcase 4: minor out of range", input: "1.256", edition: Edition{}, err: ErrEditionUnknown},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		// Act
+		edition, err := ParseEdition(tc.input)
+
+		// Assert
+		if !errors.Is(err, tc.err) {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		}
+		if edition != tc.edition {
+			t.Errorf("FAIL: %s - edition = %v; Expected: %v", tc.Name, edition, tc.edition)
+		} else {
+			t.Logf("SUCCESS: %s - edition = %v; Expected: %v", tc.Name, edition, tc.edition)
+		}
+	}
+}
+
+func TestRegistry_Editions(t *testing.T) {
+	// Arrange
+	// 1.5 is before 1.27 though the float 1.5 is greater than 1.27
+	r := NewRegistry(editionUAP(1, 27), editionUAP(1, 5), editionUAP(1, 3), editionUAP(1, 5))
+	output := []Edition{{Major: 1, Minor: 3}, {Major: 1, Minor: 5}, {Major: 1, Minor: 27}}
+
+	// Act
+	editions := r.Editions(Cat4Test.Category)
+
+	// Assert
+	if !reflect.DeepEqual(editions, output) {
+		t.Errorf("FAIL: editions = %v; Expected: %v", editions, output)
+	} else {
+		t.Logf("SUCCESS: editions = %v; Expected: %v", editions, output)
+	}
+	latest, _ := r.Latest(Cat4Test.Category)
+	if latest.Edition != output[2] {
+		t.Errorf("FAIL: latest = %v; Expected: %v", latest.Edition, output[2])
+	} else {
+		t.Logf("SUCCESS: latest = %v; Expected: %v", latest.Edition, output[2])
+	}
+}
+
+func TestRegistry_EditionNotVersion(t *testing.T) {
+	// Arrange
+	// the editions 1.27 and 1.30 have the float versions 1.27 and 1.3
+	v127 := editionUAP(1, 27)
+	v127.Version = 1.27
+	v130 := editionUAP(1, 30)
+	v130.Version = 1.3
+	// a profile without Edition has the edition of its Version
+	v12 := Cat4Test
+	v12.Edition = Edition{}
+	v12.Version = 1.2
+	output := []Edition{{Major: 1, Minor: 2}, {Major: 1, Minor: 27}, {Major: 1, Minor: 30}}
+
+	// Act
+	r := NewRegistry(v130, v127, v12)
+	editions := r.Editions(Cat4Test.Category)
+	latest, _ := r.Latest(Cat4Test.Category)
+
+	// Assert
+	if !(Edition{Major: 1, Minor: 27}).Less(Edition{Major: 1, Minor: 30}) {
+		t.Errorf("FAIL: 1.27 < 1.30 = %v; Expected: %v", false, true)
+	}
+	if !reflect.DeepEqual(editions, output) {
+		t.Errorf("FAIL: editions = %v; Expected: %v", editions, output)
+	} else {
+		t.Logf("SUCCESS: editions = %v; Expected: %v", editions, output)
+	}
+	if latest.Edition != output[2] {
+		t.Errorf("FAIL: latest = %v; Expected: %v", latest.Edition, output[2])
+	} else {
+		t.Logf("SUCCESS: latest = %v; Expected: %v", latest.Edition, output[2])
+	}
+}
+
+func TestRegistry_Pin(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name    string
+		pin     Edition
+		unpin   bool
+		err     error
+		edition Edition
+	}
+	latest := Edition{Major: 1, Minor: 27}
+	dataSet := []testCase{
+		{Name: "testcase 1: latest edition", unpin: true, err: nil, edition: latest},
+		{Name: "testcase 2: pinned edition", pin: Edition{Major: 1, Minor: 5}, err: nil, edition: Edition{Major: 1, Minor: 5}},
+		{Name: "testcase 3: unknown edition keeps the latest", pin: Edition{Major: 1, Minor: 15}, err: ErrEditionUnknown, edition: latest},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		r := NewRegistry(editionUAP(1, 5), editionUAP(1, 27))
+
+		// Act
+		var err error
+		if !tc.unpin {
+			err = r.Pin(Cat4Test.Category, tc.pin)
+		}
+		stdUAP, found := r.Profile(Cat4Test.Category)
+
+		// Assert
+		if !errors.Is(err, tc.err) {
+			t.Errorf("FAIL: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		} else {
+			t.Logf("SUCCESS: %s - err = %v; Expected: %v", tc.Name, err, tc.err)
+		}
+		if !found || stdUAP.Edition != tc.edition {
+			t.Errorf("FAIL: %s - edition = %v; Expected: %v", tc.Name, stdUAP.Edition, tc.edition)
+		} else {
+			t.Logf("SUCCESS: %s - edition = %v; Expected: %v", tc.Name, stdUAP.Edition, tc.edition)
+		}
+		r.Unpin(Cat4Test.Category)
+		if unpinned, _ := r.Profile(Cat4Test.Category); unpinned.Edition != latest {
+			t.Errorf("FAIL: %s - unpinned edition = %v; Expected: %v", tc.Name, unpinned.Edition, latest)
+		}
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	// Arrange
+	output := []Edition{{Major: 5, Minor: 1}, {Major: 6, Minor: 2}, {Major: 7, Minor: 0}}
+
+	// Act
+	editions := DefaultRegistry.Editions(30)
+	profiles := DefaultRegistry.Profiles()
+
+	// Assert
+	if !reflect.DeepEqual(editions, output) {
+		t.Errorf("FAIL: editions = %v; Expected: %v", editions, output)
+	} else {
+		t.Logf("SUCCESS: editions = %v; Expected: %v", editions, output)
+	}
+	if !reflect.DeepEqual(profiles, DefaultProfiles) {
+		t.Errorf("FAIL: profiles differ from DefaultProfiles")
+	} else {
+		t.Logf("SUCCESS: profiles = DefaultProfiles")
+	}
+	if _, found := DefaultRegistry.Lookup(48, Edition{Major: 1, Minor: 15}); found {
+		t.Errorf("FAIL: CAT048 1.15 found; Expected: not defined by the package")
+	}
+}
@@ -0,0 +1,97 @@
+package uap
+
+import "math"
+
+// Subfield describes a sub-field of the data of an item at the bit level, e.g. RHO and THETA of I048/040.
+// Offset is the position of its most significant bit from the most significant bit of the data (0),
+// Width is its number of bits (1 to 64). The data is:
+//   - the octets of a Fixed item;
+//   - the primary part followed by the extents of an Extended item, the FX bits included;
+//   - one repetition of a Repetitive or RepetitiveFX item;
+//   - the octets following the LEN of an Explicit item.
+//
+// The value of the sub-field is its raw value, in two's complement when Signed, multiplied by LSB
+// (the value of the least significant bit) and expressed in Unit, e.g. FL of I048/090: LSB 0.25, Unit "FL".
+// LSB 0 means that the raw value is not scaled.
+// Values is the table of the meanings of the raw values of an enumerated sub-field, e.g. TYP of I048/020.
+type Subfield struct {
+	Name        string       `json:"name" yaml:"name" xml:"name,attr"`
+	Description string       `json:"description,omitempty" yaml:"description,omitempty" xml:"description,attr,omitempty"`
+	Offset      uint16       `json:"offset" yaml:"offset" xml:"offset,attr"`
+	Width       uint8        `json:"width" yaml:"width" xml:"width,attr"`
+	Signed      bool         `json:"signed,omitempty" yaml:"signed,omitempty" xml:"signed,attr,omitempty"`
+	LSB         float64      `json:"lsb,omitempty" yaml:"lsb,omitempty" xml:"lsb,attr,omitempty"`
+	Unit        string       `json:"unit,omitempty" yaml:"unit,omitempty" xml:"unit,attr,omitempty"`
+	Values      []ValueTable `json:"values,omitempty" yaml:"values,omitempty" xml:"value,omitempty"`
+}
+
+// ValueTable associates a raw value of an enumerated sub-field to its meaning.
+type ValueTable struct {
+	Value   uint64 `json:"value" yaml:"value" xml:"value,attr"`
+	Meaning string `json:"meaning" yaml:"meaning" xml:",chardata"`
+}
+
+// Raw returns the raw value of the sub-field in data and true,
+// or 0 and false when data does not contain it (e.g. an extent of an Extended item which is absent).
+func (s Subfield) Raw(data []byte) (uint64, bool) {
+	if s.Width == 0 || s.Width > 64 || int(s.Offset)+int(s.Width) > 8*len(data) {
+		return 0, false
+	}
+	var v uint64
+	for i := int(s.Offset); i < int(s.Offset)+int(s.Width); i++ {
+		bit := data[i/8] >> (7 - uint(i%8)) & 0x01
+		v = v<<1 | uint64(bit)
+	}
+	return v, true
+}
+
+// Int returns the raw value of the sub-field in data, in two's complement when it is Signed, and true,
+// or 0 and false when data does not contain it.
+func (s Subfield) Int(data []byte) (int64, bool) {
+	v, ok := s.Raw(data)
+	if !ok {
+		return 0, false
+	}
+	if s.Signed && s.Width < 64 && v&(1<<(s.Width-1)) != 0 {
+		return int64(v) - int64(1)<<s.Width, true
+	}
+	return int64(v), true
+}
+
+// Value returns the value of the sub-field in data in its Unit (the raw value multiplied by LSB) and true,
+// or 0 and false when data does not contain it.
+func (s Subfield) Value(data []byte) (float64, bool) {
+	v, ok := s.Int(data)
+	if !ok {
+		return 0, false
+	}
+	if s.LSB == 0 {
+		return float64(v), true
+	}
+	return float64(v) * s.LSB, true
+}
+
+// Meaning returns the meaning of the raw value of the sub-field in data given by its Values and true,
+// or an empty string and false when data does not contain it or the value is not in the table.
+func (s Subfield) Meaning(data []byte) (string, bool) {
+	v, ok := s.Raw(data)
+	if !ok {
+		return "", false
+	}
+	for _, vt := range s.Values {
+		if vt.Value == v {
+			return vt.Meaning, true
+		}
+	}
+	return "", false
+}
+
+// fits returns true if the sub-field is within size octets.
+func (s Subfield) fits(size int) bool {
+	return s.Width != 0 && s.Width <= 64 && int(s.Offset)+int(s.Width) <= 8*size
+}
+
+// lsbValid returns true if LSB is a finite scale.
+func (s Subfield) lsbValid() bool {
+	return !math.IsNaN(s.LSB) && !math.IsInf(s.LSB, 0)
+}
@@ -0,0 +1,107 @@
+package uap
+
+import (
+	"testing"
+)
+
+func TestSubfield_Value(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name     string
+		subfield Subfield
+		input    []byte
+		raw      uint64
+		value    float64
+		ok       bool
+	}
+	dataSet := []testCase{
+		{
+			Name:     "testcase 1: RHO of I048/040",
+			subfield: Subfield{Name: "RHO", Offset: 0, Width: 16, LSB: 1.0 / 256, Unit: "NM"},
+			input:    []byte{0x94, 0xc7, 0x01, 0x81},
+			raw:      0x94c7,
+			value:    148.77734375,
+			ok:       true,
+		},
+		{
+			Name:     "testcase 2: FL of I048/090 negative in two's complement",
+			subfield: Subfield{Name: "FL", Offset: 2, Width: 14, Signed: true, LSB: 0.25, Unit: "FL"},
+			input:    []byte{0xbf, 0xfc},
+			raw:      0x3ffc,
+			value:    -1,
+			ok:       true,
+		},
+		{
+			Name:     "testcase 3: sub-field across octets",
+			subfield: Subfield{Name: "MODE3A", Offset: 4, Width: 12},
+			input:    []byte{0x09, 0x13},
+			raw:      0x913,
+			value:    0x913,
+			ok:       true,
+		},
+		{
+			Name:     "testcase 4: sub-field of an absent extent",
+			subfield: Subfield{Name: "TST", Offset: 8, Width: 1},
+			input:    []byte{0xa0},
+			raw:      0,
+			value:    0,
+			ok:       false,
+		},
+		{
+			Name:     "testcase 5: width of 64 bits",
+			subfield: Subfield{Name: "MB", Offset: 0, Width: 64, Signed: true},
+			input:    []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			raw:      0xffffffffffffffff,
+			value:    -1,
+			ok:       true,
+		},
+	}
+
+	for _, tc := range dataSet {
+		// Arrange
+		// Act
+		raw, okRaw := tc.subfield.Raw(tc.input)
+		value, ok := tc.subfield.Value(tc.input)
+
+		// Assert
+		if raw != tc.raw || okRaw != tc.ok {
+			t.Errorf("FAIL: %s - raw = %x, %v; Expected: %x, %v", tc.Name, raw, okRaw, tc.raw, tc.ok)
+		} else {
+			t.Logf("SUCCESS: %s - raw = %x, %v; Expected: %x, %v", tc.Name, raw, okRaw, tc.raw, tc.ok)
+		}
+		if value != tc.value || ok != tc.ok {
+			t.Errorf("FAIL: %s - value = %v, %v; Expected: %v, %v", tc.Name, value, ok, tc.value, tc.ok)
+		} else {
+			t.Logf("SUCCESS: %s - value = %v, %v; Expected: %v, %v", tc.Name, value, ok, tc.value, tc.ok)
+		}
+	}
+}
+
+func TestSubfield_Meaning(t *testing.T) {
+	// setup
+	type testCase struct {
+		Name    string
+		input   []byte
+		meaning string
+		ok      bool
+	}
+	dataSet := []testCase{
+		{Name: "testcase 1: TYP 5", input: []byte{0xa0}, meaning: "Single ModeS Roll-Call", ok: true},
+		{Name: "testcase 2: TYP 0", input: []byte{0x01, 0x00}, meaning: "No detection", ok: true},
+		{Name: "testcase 3: no data", input: []byte{}, meaning: "", ok: false},
+	}
+	typ := Cat048V127.Items[2].Subfields[0]
+
+	for _, tc := range dataSet {
+		// Arrange
+		// Act
+		meaning, ok := typ.Meaning(tc.input)
+
+		// Assert
+		if meaning != tc.meaning || ok != tc.ok {
+			t.Errorf("FAIL: %s - meaning = %v, %v; Expected: %v, %v", tc.Name, meaning, ok, tc.meaning, tc.ok)
+		} else {
+			t.Logf("SUCCESS: %s - meaning = %v, %v; Expected: %v, %v", tc.Name, meaning, ok, tc.meaning, tc.ok)
+		}
+	}
+}
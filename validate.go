@@ -0,0 +1,305 @@
+package goasterix
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+)
+
+var (
+	// ErrFspecInvalid reports that a FSPEC (or a primary subfield) has inconsistent FX bits,
+	// ends with an empty octet or does not match the items present.
+	ErrFspecInvalid = errors.New("[ASTERIX] FSPEC invalid")
+
+	// ErrFXInvalid reports that the FX bits of an extended item do not match its extents.
+	ErrFXInvalid = errors.New("[ASTERIX] FX bit invalid")
+
+	// ErrLenInvalid reports that a length indicator (LEN of data block, Explicit, SP, RE, REP or N of RFS)
+	// does not match the data.
+	ErrLenInvalid = errors.New("[ASTERIX] length invalid")
+)
+
+// Violation describes a non-conformance of a DataBlock or a Record to its UAP.
+// Record is the index of the record in the data block, or -1 when the violation concerns the data block itself.
+// FRN and DataItem identify the faulty item, they are empty when the violation concerns the whole record.
+type Violation struct {
+	Record   int
+	FRN      uint8
+	DataItem string
+	Err      error
+}
+
+func (v Violation) Error() string {
+	if v.FRN == 0 {
+		return fmt.Sprintf("record %d: %v", v.Record, v.Err)
+	}
+	return fmt.Sprintf("record %d, FRN %d (%s): %v", v.Record, v.FRN, v.DataItem, v.Err)
+}
+
+func (v Violation) Unwrap() error {
+	return v.Err
+}
+
+// Validate checks the conformance of a DataBlock to stdUAP and returns the violations found, nil if none.
+// It checks the category, the LEN field against the records and each record like Record.Validate.
+func (db *DataBlock) Validate(stdUAP uap.StandardUAP) []Violation {
+	var violations []Violation
+	if db.Category != stdUAP.Category {
+		violations = append(violations, Violation{Record: -1, Err: ErrCategoryMismatch})
+	}
+
+	if length, err := dataBlockLen(db.Records); err != nil || db.Len != length {
+		violations = append(violations, Violation{Record: -1, Err: ErrLenInvalid})
+	}
+
+	for i, rec := range db.Records {
+		for _, v := range rec.Validate(stdUAP) {
+			v.Record = i
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
+
+// Validate checks the conformance of a Record to stdUAP and returns the violations found, nil if none.
+// The Record field of the violations is 0.
+// It checks:
+// - the FSPEC: FX bits, no bit set for a FRN not defined or spare in the UAP, one item per FRN;
+// - the size of Fixed items;
+// - the FX bits and the size of the extents of Extended items and of the repetitions of RepetitiveFX items;
+// - the LEN of Explicit, SP and RE items (at least 1 and matching the data);
+// - the REP factor of Repetitive items against the data;
+// - the primary subfield and the sub-items of Compound items, and of the compounds held by Explicit and
+// Repetitive items;
+// - the N factor and the fields of RFS items.
+func (rec *Record) Validate(stdUAP uap.StandardUAP) []Violation {
+	var violations []Violation
+	cond := stdUAP.EffectiveCondition()
+	if rec.Cat != stdUAP.Category {
+		violations = append(violations, Violation{Err: ErrCategoryMismatch})
+	}
+	if !validFspec(rec.Fspec) {
+		violations = append(violations, Violation{Err: ErrFspecInvalid})
+	}
+
+	frnIndex := FspecIndex(rec.Fspec)
+	if len(frnIndex) != len(rec.Items) {
+		violations = append(violations, Violation{Err: ErrFspecInvalid})
+	}
+
+	fields := stdUAP.Items
+	for i, frn := range frnIndex {
+		field, found := lookupDataField(fields, MetaItem{FRN: frn})
+		if !found {
+			violations = append(violations, Violation{FRN: frn, Err: ErrFRNUnknown})
+			continue
+		}
+		if i >= len(rec.Items) {
+			continue
+		}
+		item := rec.Items[i]
+		if item.Meta.FRN != frn {
+			violations = append(violations, Violation{FRN: frn, DataItem: field.DataItem, Err: ErrFspecInvalid})
+			continue
+		}
+
+		err := validateItem(item, field, fields)
+		if err != nil {
+			violations = append(violations, Violation{FRN: frn, DataItem: field.DataItem, Err: err})
+			continue
+		}
+
+		if cond != nil && frn == cond.FRN {
+			fields, err = selectUAPConditional(cond, item)
+			if err != nil {
+				violations = append(violations, Violation{FRN: frn, DataItem: field.DataItem, Err: err})
+				return violations
+			}
+		}
+	}
+	return violations
+}
+
+// validFspec returns true if the FX bit is set on each octet except the last one,
+// and the last octet is not empty (except for an empty FSPEC of one octet).
+func validFspec(fspec []byte) bool {
+	if len(fspec) == 0 || len(fspec) > maxFspecLength {
+		return false
+	}
+	for i, b := range fspec {
+		last := i == len(fspec)-1
+		if (b&0x01 != 0) == last {
+			return false
+		}
+	}
+	return len(fspec) == 1 || fspec[len(fspec)-1] != 0
+}
+
+// validateItem checks item against its data field of the UAP,
+// the data fields of items are used to check the fields of a RFS item.
+func validateItem(item Item, field uap.DataField, items []uap.DataField) error {
+	if item.Meta.Type != field.Type {
+		return ErrItemMalformed
+	}
+
+	switch field.Type {
+	case uap.Fixed:
+		if item.Fixed == nil || len(item.Fixed.Data) != int(field.Fixed.Size) {
+			return ErrItemMalformed
+		}
+
+	case uap.Extended:
+		if item.Extended == nil {
+			return ErrItemMalformed
+		}
+		return validateExtended(*item.Extended, field.Extended)
+
+	case uap.Explicit:
+		if item.Explicit == nil {
+			return ErrItemMalformed
+		}
+		if field.Explicit.Compound != nil {
+			if item.Explicit.Compound == nil || !bytes.Equal(item.Explicit.Compound.Payload(), item.Explicit.Data) {
+				return ErrItemMalformed
+			}
+			err := validateCompound(*item.Explicit.Compound, field.Explicit.Compound)
+			if err != nil {
+				return err
+			}
+		}
+		return validateLen(item.Explicit.Len, len(item.Explicit.Data))
+
+	case uap.SP, uap.RE:
+		if item.SP == nil {
+			return ErrItemMalformed
+		}
+		return validateLen(item.SP.Len, len(item.SP.Data))
+
+	case uap.Repetitive:
+		if item.Repetitive == nil {
+			return ErrItemMalformed
+		}
+		if field.Repetitive.Compound != nil {
+			if len(item.Repetitive.Compounds) != int(item.Repetitive.Rep) {
+				return ErrLenInvalid
+			}
+			var data []byte
+			for _, cp := range item.Repetitive.Compounds {
+				err := validateCompound(cp, field.Repetitive.Compound)
+				if err != nil {
+					return err
+				}
+				data = append(data, cp.Payload()...)
+			}
+			if !bytes.Equal(data, item.Repetitive.Data) {
+				return ErrItemMalformed
+			}
+			return nil
+		}
+		if len(item.Repetitive.Data) != int(item.Repetitive.Rep)*int(field.Repetitive.SubItemSize) {
+			return ErrLenInvalid
+		}
+
+	case uap.RepetitiveFX:
+		if item.RepetitiveFX == nil || len(item.RepetitiveFX.Elements) == 0 {
+			return ErrItemMalformed
+		}
+		size := int(field.Repetitive.SubItemSize)
+		for i, e := range item.RepetitiveFX.Elements {
+			if size == 0 || len(e) != size {
+				return ErrItemMalformed
+			}
+			last := i == len(item.RepetitiveFX.Elements)-1
+			if (e[size-1]&0x01 != 0) == last {
+				return ErrFXInvalid
+			}
+		}
+
+	case uap.Compound:
+		if item.Compound == nil {
+			return ErrItemMalformed
+		}
+		return validateCompound(*item.Compound, field.Compound)
+
+	case uap.RFS:
+		if item.RFS == nil {
+			return ErrItemMalformed
+		}
+		if int(item.RFS.N) != len(item.RFS.Sequence) {
+			return ErrLenInvalid
+		}
+		for _, rf := range item.RFS.Sequence {
+			f, found := lookupRandomField(items, rf.FRN)
+			if !found {
+				return ErrFRNUnknown
+			}
+			err := validateItem(rf.Field, f, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+	default:
+		return ErrDataFieldUnknown
+	}
+	return nil
+}
+
+// validateCompound checks the primary subfield of cp and its sub-items against the data fields of the compound.
+func validateCompound(cp Compound, fields []uap.DataField) error {
+	if !validFspec(cp.Primary) {
+		return ErrFspecInvalid
+	}
+	frnIndex := FspecIndex(cp.Primary)
+	if len(frnIndex) != len(cp.Secondary) {
+		return ErrFspecInvalid
+	}
+	for i, frn := range frnIndex {
+		sub, found := lookupDataField(fields, MetaItem{FRN: frn})
+		if !found {
+			return ErrFRNUnknown
+		}
+		if cp.Secondary[i].Meta.FRN != frn {
+			return ErrFspecInvalid
+		}
+		err := validateItem(cp.Secondary[i], sub, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateExtended checks the size of the parts of e and that the FX bit of each part is set
+// only when a next part follows.
+func validateExtended(e Extended, field uap.ExtendedField) error {
+	primarySize := int(field.PrimarySize)
+	secondarySize := int(field.SecondarySize)
+	if primarySize == 0 || len(e.Primary) != primarySize {
+		return ErrItemMalformed
+	}
+	if len(e.Secondary) != 0 && (secondarySize == 0 || len(e.Secondary)%secondarySize != 0) {
+		return ErrItemMalformed
+	}
+
+	if (e.Primary[primarySize-1]&0x01 != 0) != (len(e.Secondary) != 0) {
+		return ErrFXInvalid
+	}
+	for i := secondarySize - 1; i < len(e.Secondary); i += secondarySize {
+		last := i == len(e.Secondary)-1
+		if (e.Secondary[i]&0x01 != 0) == last {
+			return ErrFXInvalid
+		}
+	}
+	return nil
+}
+
+// validateLen checks that the length indicator l (including itself) is at least 1 and matches the size of the data.
+func validateLen(l uint8, size int) error {
+	if l == 0 || int(l) != size+1 {
+		return ErrLenInvalid
+	}
+	return nil
+}
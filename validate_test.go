@@ -0,0 +1,157 @@
+package goasterix
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mokhtarimokhtar/goasterix/uap"
+	"github.com/mokhtarimokhtar/goasterix/util"
+)
+
+func TestRecord_Validate(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		modify       func(rec *Record)
+		output       []Violation
+	}
+	// FRN 1 fixed, 2 extended, 3 explicit, 4 repetitive, 5 compound, 6 RFS, 9 SP
+	input := "fd 40 ffff fffffe 03ffff 02ffffffff ab80 ff fffe 02ffffffff 04ffffff ffff 0101ffff 03ffff"
+	dataSet := []dataTest{
+		{
+			TestCaseName: "valid",
+			modify:       func(rec *Record) {},
+			output:       nil,
+		},
+		{
+			TestCaseName: "category mismatch",
+			modify:       func(rec *Record) { rec.Cat = 1 },
+			output:       []Violation{{Err: ErrCategoryMismatch}},
+		},
+		{
+			TestCaseName: "FSPEC bit of spare FRN",
+			modify:       func(rec *Record) { rec.Fspec[0] |= 0x02 },
+			output: []Violation{
+				{Err: ErrFspecInvalid},
+				{FRN: 7, Err: ErrFRNUnknown},
+			},
+		},
+		{
+			TestCaseName: "FSPEC bit beyond UAP",
+			modify:       func(rec *Record) { rec.Fspec = []byte{0xfd, 0x41, 0x80} },
+			output: []Violation{
+				{Err: ErrFspecInvalid},
+				{FRN: 15, Err: ErrFRNUnknown},
+			},
+		},
+		{
+			TestCaseName: "FSPEC FX not set",
+			modify:       func(rec *Record) { rec.Fspec[0] &^= 0x01 },
+			output:       []Violation{{Err: ErrFspecInvalid}},
+		},
+		{
+			TestCaseName: "fixed size",
+			modify:       func(rec *Record) { rec.Items[0].Fixed.Data = []byte{0xff} },
+			output:       []Violation{{FRN: 1, DataItem: "I026/001", Err: ErrItemMalformed}},
+		},
+		{
+			TestCaseName: "extended FX set on last extent",
+			modify:       func(rec *Record) { rec.Items[1].Extended.Secondary[1] = 0xff },
+			output:       []Violation{{FRN: 2, DataItem: "I026/002", Err: ErrFXInvalid}},
+		},
+		{
+			TestCaseName: "explicit LEN zero",
+			modify:       func(rec *Record) { rec.Items[2].Explicit.Len = 0 },
+			output:       []Violation{{FRN: 3, DataItem: "I026/003", Err: ErrLenInvalid}},
+		},
+		{
+			TestCaseName: "repetitive REP",
+			modify:       func(rec *Record) { rec.Items[3].Repetitive.Rep = 3 },
+			output:       []Violation{{FRN: 4, DataItem: "I026/004", Err: ErrLenInvalid}},
+		},
+		{
+			TestCaseName: "compound primary",
+			modify:       func(rec *Record) { rec.Items[4].Compound.Primary[1] = 0x81 },
+			output:       []Violation{{FRN: 5, DataItem: "I026/005", Err: ErrFspecInvalid}},
+		},
+		{
+			TestCaseName: "RFS N",
+			modify:       func(rec *Record) { rec.Items[5].RFS.N = 2 },
+			output:       []Violation{{FRN: 6, DataItem: "I026/006", Err: ErrLenInvalid}},
+		},
+		{
+			TestCaseName: "SP LEN",
+			modify:       func(rec *Record) { rec.Items[6].SP.Len = 4 },
+			output:       []Violation{{FRN: 9, DataItem: "SP", Err: ErrLenInvalid}},
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(input)
+		rec := NewRecord()
+		_, err := rec.Decode(data, uap.Cat4Test)
+		if err != nil {
+			t.Fatalf("FAIL: %s decoding error: %v", row.TestCaseName, err)
+		}
+		row.modify(rec)
+
+		// Act
+		violations := rec.Validate(uap.Cat4Test)
+
+		// Assert
+		if reflect.DeepEqual(violations, row.output) == false {
+			t.Errorf("FAIL: %s violations = %v; Expected: %v", row.TestCaseName, violations, row.output)
+		} else {
+			t.Logf("SUCCESS: violations = %v; Expected: %v", violations, row.output)
+		}
+	}
+}
+
+func TestDataBlock_Validate(t *testing.T) {
+	// setup
+	type dataTest struct {
+		TestCaseName string
+		modify       func(db *DataBlock)
+		output       []Violation
+	}
+	input := "30002d e0 0836 429b52 a0 e0 0836 429b52 a0 e0 0836 429b52 a0 e0 0836 429b52 a0 e0 0836 429b52 a0 e0 0836 429b52 a0"
+	dataSet := []dataTest{
+		{
+			TestCaseName: "valid",
+			modify:       func(db *DataBlock) {},
+			output:       nil,
+		},
+		{
+			TestCaseName: "LEN mismatch",
+			modify:       func(db *DataBlock) { db.Len++ },
+			output:       []Violation{{Record: -1, Err: ErrLenInvalid}},
+		},
+		{
+			TestCaseName: "record invalid",
+			modify:       func(db *DataBlock) { db.Records[2].Items[2].Extended.Primary[0] = 0xa1 },
+			output:       []Violation{{Record: 2, FRN: 3, DataItem: "I048/020", Err: ErrFXInvalid}},
+		},
+	}
+
+	for _, row := range dataSet {
+		// Arrange
+		data, _ := util.HexStringToByte(input)
+		db := NewDataBlock()
+		_, err := db.Decode(data)
+		if err != nil {
+			t.Fatalf("FAIL: %s decoding error: %v", row.TestCaseName, err)
+		}
+		row.modify(db)
+
+		// Act
+		violations := db.Validate(uap.Cat048V127)
+
+		// Assert
+		if reflect.DeepEqual(violations, row.output) == false {
+			t.Errorf("FAIL: %s violations = %v; Expected: %v", row.TestCaseName, violations, row.output)
+		} else {
+			t.Logf("SUCCESS: violations = %v; Expected: %v", violations, row.output)
+		}
+	}
+}